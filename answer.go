@@ -20,6 +20,157 @@ type Answer interface {
 	Step
 }
 
+// computedAnswer represents an answer that is computed by an AnswerFunc.
+const computedAnswer = "<computed>"
+
+// AnswerFunc computes the answer from the screen when the prompt is asked, with the values that are captured so far.
+type AnswerFunc func(screen Screen, captures map[string]string) string
+
+// answerOf returns the answer, or computes it when there is a function.
+func answerOf(c Console, parent *Survey, answer string, fn AnswerFunc) (string, error) {
+	if fn == nil {
+		return answer, nil
+	}
+
+	s, err := readScreen(c)
+	if err != nil {
+		return "", err
+	}
+
+	return fn(s, parent.Captures()), nil
+}
+
+// retryable is an answer that can be rejected by a validator, so the prompt is asked again.
+type retryable interface {
+	Answer
+
+	// expectsRetry checks whether the prompt is expected to be asked again after the answer.
+	expectsRetry() bool
+}
+
+// RetryAnswers is a sequence of answers to a prompt that is asked again after a validation error.
+type RetryAnswers struct {
+	answers []Step
+
+	// wait is called before giving the next answer when the prompt is asked again.
+	wait func(c Console) error
+}
+
+// Do runs the step.
+func (a *RetryAnswers) Do(c Console) error {
+	for i, answer := range a.answers {
+		if i > 0 && a.wait != nil {
+			if err := a.wait(c); err != nil {
+				return err
+			}
+		}
+
+		if err := answer.Do(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (a *RetryAnswers) String() string {
+	var sb stringsBuilder
+
+	for i, answer := range a.answers {
+		if i > 0 {
+			sb.WriteString("\n         ")
+		}
+
+		sb.WriteString(answer.String())
+	}
+
+	return sb.String()
+}
+
+func (a *RetryAnswers) expectsRetry() bool {
+	last, ok := a.answers[len(a.answers)-1].(retryable)
+
+	return ok && last.expectsRetry()
+}
+
+// expectsRetry checks whether the prompt is expected to be asked again after the answer.
+func expectsRetry(answer Step) bool {
+	r, ok := answer.(retryable)
+
+	return ok && r.expectsRetry()
+}
+
+// retryAnswer appends the next answer to the previous one if the prompt is expected to be asked again, otherwise the
+// next answer replaces the previous one.
+func retryAnswer(prev, next Step, wait func(c Console) error) Step {
+	if !expectsRetry(prev) {
+		return next
+	}
+
+	if answers, ok := prev.(*RetryAnswers); ok {
+		answers.answers = append(answers.answers, next)
+
+		return answers
+	}
+
+	return &RetryAnswers{
+		answers: []Step{prev, next},
+		wait:    wait,
+	}
+}
+
+// SequenceAnswers gives a different answer each time the prompt is asked.
+type SequenceAnswers struct {
+	prompt  *basePrompt
+	answers []Step
+}
+
+// Do runs the step.
+func (a *SequenceAnswers) Do(c Console) error {
+	a.prompt.lock()
+	occurrence := a.prompt.totalCalls
+	a.prompt.unlock()
+
+	if occurrence >= len(a.answers) {
+		return fmt.Errorf("%w: the prompt is asked for the #%d time", ErrNoMoreAnswers, occurrence+1)
+	}
+
+	return a.answers[occurrence].Do(c)
+}
+
+// String represents the answer as a string. Only the answers to the remaining occurrences are shown, the first one is
+// the occurrence that is being answered.
+func (a *SequenceAnswers) String() string {
+	var sb stringsBuilder
+
+	for i := a.prompt.totalCalls; i < len(a.answers); i++ {
+		if i > a.prompt.totalCalls {
+			sb.WriteString(", ")
+		}
+
+		sb.Writef("#%d %s", i+1, a.answers[i].String())
+	}
+
+	return sb.String()
+}
+
+// sequenceAnswers gives the answers in order, one for each time the prompt is asked. The prompt is expected to be
+// asked as many times as the number of answers.
+func sequenceAnswers(p *basePrompt, answers ...Step) *SequenceAnswers {
+	p.timesLocked(len(answers))
+
+	return &SequenceAnswers{
+		prompt:  p,
+		answers: answers,
+	}
+}
+
+// validationFeedback returns the feedback that survey shows when the answer is rejected by a validator.
+func validationFeedback(err string) string {
+	return fmt.Sprintf("Sorry, your reply was invalid: %s", err)
+}
+
 // NoAnswer sends an empty line to answer the question.
 type NoAnswer struct{}
 
@@ -80,6 +231,11 @@ func (a *HelpAnswer) String() string {
 	return a.icon
 }
 
+// expectsRetry is always true, the prompt is asked again after showing the help.
+func (a *HelpAnswer) expectsRetry() bool {
+	return true
+}
+
 func helpAnswer(help string, options ...string) *HelpAnswer {
 	if len(options) == 0 {
 		options = append(options, "?")
@@ -156,6 +312,315 @@ func pressDelete() *Action {
 	return action(terminal.KeyDelete, "DELETE")
 }
 
+func pressBackspace() *Action {
+	return action(terminal.KeyBackspace, "BACKSPACE")
+}
+
+// The special keys of survey are control characters that the terminal may take for itself, ^Q for END for example, so
+// the escape sequences are sent instead.
+func pressHome() *PressAction {
+	return pressKeys(KeyHome)
+}
+
+func pressEnd() *PressAction {
+	return pressKeys(KeyEnd)
+}
+
+func pressVimUp() *Action {
+	return action('k', "k")
+}
+
+func pressVimDown() *Action {
+	return action('j', "j")
+}
+
+// VimModeAction sends the ESC key to toggle the vim mode of a select or multiselect list.
+type VimModeAction struct{}
+
+// Do runs the step.
+func (a *VimModeAction) Do(c Console) error {
+	// Survey only takes ESC as a key when nothing follows it in the input, so the pending output is drained before
+	// sending it and the step waits for the list to be rendered again.
+	if _, err := readScreen(c); err != nil {
+		return err
+	}
+
+	if err := pressEsc().Do(c); err != nil {
+		return err
+	}
+
+	_, err := readRenderedPrompt(c)
+
+	return err
+}
+
+// String represents the answer as a string.
+func (a *VimModeAction) String() string {
+	return "press ESC to toggle vim mode"
+}
+
+func toggleVimMode() *VimModeAction {
+	return &VimModeAction{}
+}
+
+// VimModeProbe finds out whether a select or multiselect list is in vim mode. Survey does not render the mode, so the
+// probe sends "j", which moves the cursor in vim mode and goes to the filter otherwise. The key is then taken back with
+// "k" or BACKSPACE, the option that was highlighted is highlighted again because changing the filter may move the
+// cursor, and the list is expected to be rendered as it was before the probe.
+type VimModeProbe struct {
+	message     func() string
+	enabled     bool
+	multiselect bool
+}
+
+// Do runs the step.
+func (a *VimModeProbe) Do(c Console) error {
+	before, err := readOptionList(c, a.multiselect)
+	if err != nil {
+		return err
+	}
+
+	// Survey types "j" to the filter and leaves vim mode when there is no option to move to.
+	highlighted := highlightedIndex(before.options)
+	if highlighted < 0 {
+		return fmt.Errorf("%w: there is no option to move to", ErrVimModeUnknown)
+	}
+
+	if err := pressVimDown().Do(c); err != nil {
+		return err
+	}
+
+	after, err := readOptionList(c, a.multiselect)
+	if err != nil {
+		return err
+	}
+
+	enabled := after.filter(a.message()) == before.filter(a.message())
+	undo := pressVimUp()
+
+	if !enabled {
+		undo = pressDelete()
+	}
+
+	if err := undo.Do(c); err != nil {
+		return err
+	}
+
+	if err := a.restore(c, before, before.options[highlighted].value); err != nil {
+		return err
+	}
+
+	if enabled != a.enabled {
+		return fmt.Errorf("%w: expected %s, got %s", ErrUnexpectedVimMode, vimModeState(a.enabled), vimModeState(enabled))
+	}
+
+	return nil
+}
+
+// restore highlights the option that was highlighted before the probe and expects the list to be rendered as before.
+func (a *VimModeProbe) restore(c Console, before optionList, highlighted string) error {
+	after, err := readOptionList(c, a.multiselect)
+	if err != nil {
+		return err
+	}
+
+	if highlightedOption(after.options) != highlighted {
+		if _, err := highlightOption(c, optionLabel(highlighted), a.multiselect); err != nil {
+			return err
+		}
+
+		if after, err = readOptionList(c, a.multiselect); err != nil {
+			return err
+		}
+	}
+
+	expected := formatOptions(before.options, a.multiselect)
+
+	if actual := formatOptions(after.options, a.multiselect); actual != expected ||
+		after.filter(a.message()) != before.filter(a.message()) {
+		return fmt.Errorf("%w: the list is not restored after probing vim mode, expected:\n%s\ngot:\n%s",
+			ErrUnexpectedOptions, expected, actual,
+		)
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (a *VimModeProbe) String() string {
+	return fmt.Sprintf("press j to probe vim mode, expect %s", vimModeState(a.enabled))
+}
+
+func probeVimMode(message func() string, enabled, multiselect bool) *VimModeProbe {
+	return &VimModeProbe{
+		message:     message,
+		enabled:     enabled,
+		multiselect: multiselect,
+	}
+}
+
+func vimModeState(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+
+	return "disabled"
+}
+
+// ChooseAction highlights an option of a select list and sends the ENTER key.
+type ChooseAction struct {
+	target optionTarget
+}
+
+// Do runs the step.
+func (a *ChooseAction) Do(c Console) error {
+	if _, err := highlightOption(c, a.target, false); err != nil {
+		return err
+	}
+
+	return pressEnter().Do(c)
+}
+
+// String represents the answer as a string.
+func (a *ChooseAction) String() string {
+	return fmt.Sprintf("choose %s", a.target)
+}
+
+func chooseOption(target optionTarget) *ChooseAction {
+	return &ChooseAction{target: target}
+}
+
+// AcceptAction highlights a suggestion of an input prompt, confirms that the input shows it and sends the ENTER key.
+type AcceptAction struct {
+	message    func() string
+	suggestion string
+}
+
+// Do runs the step.
+func (a *AcceptAction) Do(c Console) error {
+	if _, err := highlightOption(c, optionLabel(a.suggestion), false); err != nil {
+		return err
+	}
+
+	if err := expectFilter(a.message, a.suggestion).Do(c); err != nil {
+		return err
+	}
+
+	return pressEnter().Do(c)
+}
+
+// String represents the answer as a string.
+func (a *AcceptAction) String() string {
+	return fmt.Sprintf("accept %q", a.suggestion)
+}
+
+func acceptSuggestion(message func() string, suggestion string) *AcceptAction {
+	return &AcceptAction{
+		message:    message,
+		suggestion: suggestion,
+	}
+}
+
+// ScrollAction scrolls a select or multiselect list down until an option is visible.
+type ScrollAction struct {
+	option      string
+	texts       *optionTexts
+	multiselect bool
+}
+
+// Do runs the step.
+func (a *ScrollAction) Do(c Console) error {
+	_, _, err := scrollToOption(c, a.texts.option(a.option), a.multiselect)
+
+	return err
+}
+
+// String represents the answer as a string.
+func (a *ScrollAction) String() string {
+	return fmt.Sprintf("scroll to %q", a.option)
+}
+
+func scrollTo(multiselect bool, texts *optionTexts, option string) *ScrollAction {
+	return &ScrollAction{
+		option:      option,
+		texts:       texts,
+		multiselect: multiselect,
+	}
+}
+
+// ToggleAction checks or unchecks options of a multiselect list.
+type ToggleAction struct {
+	options []string
+	texts   *optionTexts
+	checked bool
+}
+
+// Do runs the step.
+func (a *ToggleAction) Do(c Console) error {
+	for _, label := range a.options {
+		o, err := highlightOption(c, a.texts.option(label), true)
+		if err != nil {
+			return err
+		}
+
+		if o.checked == a.checked {
+			continue
+		}
+
+		if err := pressSpace().Do(c); err != nil {
+			return err
+		}
+
+		// Survey clears the filter after toggling an option, so the option might have moved or scrolled away.
+		if o, err = findOption(c, a.texts.option(label)); err != nil {
+			return err
+		}
+
+		if o.checked != a.checked {
+			return fmt.Errorf("%w: expected %q to be %s", ErrUnexpectedCheckedState, label, a.state())
+		}
+	}
+
+	return nil
+}
+
+func (a *ToggleAction) state() string {
+	if a.checked {
+		return "checked"
+	}
+
+	return "unchecked"
+}
+
+// String represents the answer as a string.
+func (a *ToggleAction) String() string {
+	var sb stringsBuilder
+
+	if a.checked {
+		sb.WriteString("check ")
+	} else {
+		sb.WriteString("uncheck ")
+	}
+
+	for i, o := range a.options {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		sb.Writef("%q", o)
+	}
+
+	return sb.String()
+}
+
+func toggleOptions(texts *optionTexts, checked bool, options ...string) *ToggleAction {
+	return &ToggleAction{
+		options: options,
+		texts:   texts,
+		checked: checked,
+	}
+}
+
 // HelpAction sends a ? to show the help.
 type HelpAction struct {
 	help string
@@ -211,3 +676,31 @@ func typeAnswer(answer string) *TypeAnswer {
 		answer: answer,
 	}
 }
+
+// SubmitAction checks options of a multiselect list and sends the ENTER key.
+type SubmitAction struct {
+	options []string
+	texts   *optionTexts
+}
+
+// Do runs the step.
+func (a *SubmitAction) Do(c Console) error {
+	if err := toggleOptions(a.texts, true, a.options...).Do(c); err != nil {
+		return err
+	}
+
+	return pressEnter().Do(c)
+}
+
+// String represents the answer as a string.
+func (a *SubmitAction) String() string {
+	if len(a.options) == 0 {
+		return pressEnter().String()
+	}
+
+	return fmt.Sprintf("%s and press ENTER", toggleOptions(a.texts, true, a.options...).String())
+}
+
+func submitOptions(texts *optionTexts, options ...string) *SubmitAction {
+	return &SubmitAction{options: options, texts: texts}
+}
@@ -17,3 +17,96 @@ func TestInterruptAnswer_String(t *testing.T) {
 
 	assert.Equal(t, "<interrupt>", interruptAnswer().String())
 }
+
+func TestRetryAnswers_String(t *testing.T) {
+	t.Parallel()
+
+	p := newInput(&Survey{}, Exact("Enter your name:"))
+
+	p.Answer("").ExpectValidationError("Value is required").
+		Answer("john")
+
+	expected := "Expect : Input Prompt\nMessage: \"Enter your name:\"\nAnswer : \"\" and get validation error \"Value is required\"\n         \"john\"\n"
+
+	assert.Equal(t, expected, p.String())
+}
+
+func TestRetryAnswer(t *testing.T) {
+	t.Parallel()
+
+	p := newInput(&Survey{}, Exact("Enter your name:"))
+
+	p.Answer("first")
+	second := p.Answer("second")
+
+	assert.Equal(t, second, p.answer, "the answer is replaced when no validation error is expected")
+
+	second.ExpectValidationError("Value is required")
+	third := p.Answer("third")
+
+	retry, ok := p.answer.(*RetryAnswers)
+
+	assert.True(t, ok)
+	assert.Equal(t, []Step{second, third}, retry.answers)
+	assert.NotNil(t, retry.wait)
+}
+
+func TestChooseAction_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `choose "English"`, chooseOption(optionLabel("English")).String())
+	assert.Equal(t, "choose #2", chooseOption(optionIndex(2)).String())
+}
+
+func TestToggleAction_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `check "Go", "Rust"`, toggleOptions(nil, true, "Go", "Rust").String())
+	assert.Equal(t, `uncheck "Go"`, toggleOptions(nil, false, "Go").String())
+}
+
+func TestScrollAction_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `scroll to "Region 12"`, scrollTo(false, nil, "Region 12").String())
+}
+
+func TestVimModeAction_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "press ESC to toggle vim mode", toggleVimMode().String())
+}
+
+func TestVimModeProbe_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "press j to probe vim mode, expect enabled", probeVimMode(staticText("Select a country"), true, false).String())
+	assert.Equal(t, "press j to probe vim mode, expect disabled", probeVimMode(staticText("Select a country"), false, true).String())
+}
+
+func TestPressAction_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `press "hello<TAB><ENTER>"`, pressKeys("hello", KeyTab, KeyEnter).String())
+}
+
+func TestSequenceAnswers_String(t *testing.T) {
+	t.Parallel()
+
+	p := &basePrompt{parent: &Survey{}}
+	a := sequenceAnswers(p, noAnswer(), interruptAnswer(), noAnswer())
+
+	assert.Equal(t, "#1 <no answer>, #2 <interrupt>, #3 <no answer>", a.String())
+	assert.Equal(t, 3, p.repeatability)
+
+	p.totalCalls = 2
+
+	assert.Equal(t, "#3 <no answer>", a.String())
+}
+
+func TestSubmitAction_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `check "Go", "Rust" and press ENTER`, submitOptions(nil, "Go", "Rust").String())
+	assert.Equal(t, "press ENTER", submitOptions(nil).String())
+}
@@ -0,0 +1,128 @@
+package surveyexpect
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/Netflix/go-expect"
+)
+
+// answeredMatcher matches the line that survey renders once the prompt is answered, when it shows the expected answer.
+type answeredMatcher struct {
+	console *terminalConsole
+	message string
+	answer  string
+	// echoed indicates that the answer is not rendered again, the line that is typed is the answered line.
+	echoed bool
+	// rendered indicates that the answered line is rendered, whatever the answer is.
+	rendered bool
+	matched  bool
+}
+
+// Match checks the screen each time a line is rendered.
+func (m *answeredMatcher) Match(v interface{}) bool {
+	buf, ok := v.(*bytes.Buffer)
+	if !ok {
+		return false
+	}
+
+	// The answer that is typed is echoed before being rendered again, so only the rendered line counts.
+	if !m.echoed && !strings.HasSuffix(buf.String(), "\n") {
+		return false
+	}
+
+	s := m.console.snapshot()
+
+	actual, row := renderedAnswer(s, m.message)
+	if row < 0 {
+		return false
+	}
+
+	// The lists and the suggestions are rendered below the message while the prompt is being answered.
+	if row == s.cursorY-1 {
+		m.rendered = true
+	}
+
+	m.matched = actual == m.answer
+
+	return m.matched
+}
+
+// Criteria returns the expected answer.
+func (m *answeredMatcher) Criteria() interface{} {
+	return m.answer
+}
+
+// expectAnswered expects the answer that survey renders next to the message once the prompt is answered. When the
+// output settles after the answered line is rendered with another answer, the rendered answer is reported.
+func expectAnswered(c Console, message, answer string, echoed bool) error {
+	tc, ok := c.(*terminalConsole)
+	if !ok {
+		return ErrScreenUnavailable
+	}
+
+	m := &answeredMatcher{console: tc, message: message, answer: answer, echoed: echoed}
+
+	for !m.matched && !m.rendered {
+		if _, err := c.Expect(expectAnsweredLine(m), expect.WithTimeout(ScreenSettleTime), readTimeout,
+			expect.EOF, expect.PTSClosed,
+		); err != nil {
+			// The console is closed when the survey is done, maybe before the answer is read.
+			return checkAnswered(tc.replay(), message, answer)
+		}
+	}
+
+	if m.matched {
+		return nil
+	}
+
+	return checkAnswered(tc.snapshot(), message, answer)
+}
+
+// expectAnsweredLine expects the answered line in the console.
+func expectAnsweredLine(m *answeredMatcher) expect.ExpectOpt {
+	return func(opts *expect.ExpectOpts) error {
+		opts.Matchers = append(opts.Matchers, m)
+
+		return nil
+	}
+}
+
+// checkAnswered checks the answer that is rendered on the screen.
+func checkAnswered(s Screen, message, answer string) error {
+	if actual, _ := renderedAnswer(s, message); actual != answer {
+		return fmt.Errorf("%w: expected %q, got %q", ErrUnexpectedAnswer, answer, actual)
+	}
+
+	return nil
+}
+
+// renderedAnswer finds the answer that is rendered next to the message, on the last line of the prompt above the
+// cursor, and the row of that line, or -1 if there is none. A multiline answer continues on the next lines, until the
+// next prompt if it is already rendered.
+func renderedAnswer(s Screen, message string) (string, int) {
+	end := s.cursorY
+	if end > len(s.lines) {
+		end = len(s.lines)
+	}
+
+	for y := end - 1; y >= 0; y-- {
+		if !strings.HasPrefix(s.lines[y], "? ") {
+			continue
+		}
+
+		i := strings.Index(s.lines[y], message)
+		if i < 0 {
+			end = y
+
+			continue
+		}
+
+		lines := append([]string{s.lines[y][i+len(message):]}, s.lines[y+1:end]...)
+
+		return strings.TrimSpace(strings.Join(lines, "\n")), y
+	}
+
+	return "", -1
+}
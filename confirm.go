@@ -2,31 +2,56 @@ package surveyexpect
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 )
 
 var (
 	_ Prompt = (*ConfirmPrompt)(nil)
 	_ Answer = (*ConfirmAnswer)(nil)
+
+	confirmDefaultRegex = regexp.MustCompile(`\((y/N|Y/n)\) $`)
+
+	// The answers that survey accepts, in any case.
+	confirmYesRegex = regexp.MustCompile(`^(?i:y(?:es)?)$`)
+	confirmNoRegex  = regexp.MustCompile(`^(?i:n(?:o)?)$`)
 )
 
 // ConfirmPrompt is an expectation of survey.Confirm.
 type ConfirmPrompt struct {
 	*basePrompt
 
-	message string
-	answer  Answer
+	defaultValue *bool
+	answer       Answer
 }
 
-// ShowHelp sets help for the expectation.
+// ExpectDefault expects the default value to be shown next to the message, (Y/n) for true and (y/N) for false.
+//
+//	Survey.ExpectConfirm("Are you sure to delete this file?").
+//		ExpectDefault(true).
+//		No()
+func (c *ConfirmPrompt) ExpectDefault(value bool) *ConfirmPrompt {
+	c.lock()
+	defer c.unlock()
+
+	c.defaultValue = &value
+
+	return c
+}
+
+// ShowHelp asks for help and asserts the help text. The prompt is asked again after showing the help, so the next
+// answer is given to the same prompt.
 //
 //	Survey.ExpectConfirm("Are you sure to delete this file?").
 //		ShowHelp("The file will be permanently deleted").
-func (c *ConfirmPrompt) ShowHelp(help string, options ...string) {
+//		Yes()
+func (c *ConfirmPrompt) ShowHelp(help string, options ...string) *ConfirmPrompt {
 	c.lock()
 	defer c.unlock()
 
-	c.answer = helpAnswer(help, options...)
+	c.answer = retryAnswer(c.answer, helpAnswer(help, options...), waitForCursorTwice)
+
+	return c
 }
 
 // Interrupt marks the answer is interrupted.
@@ -37,7 +62,8 @@ func (c *ConfirmPrompt) Interrupt() {
 	c.lock()
 	defer c.unlock()
 
-	c.answer = interruptAnswer()
+	c.answer = retryAnswer(c.answer, interruptAnswer(), waitForCursorTwice)
+	c.timesLocked(1)
 }
 
 // Yes sets "yes" as the answer to the prompt.
@@ -48,8 +74,7 @@ func (c *ConfirmPrompt) Yes() {
 	c.lock()
 	defer c.unlock()
 
-	a := newConfirmAnswer(c, "yes")
-	c.answer = a
+	c.answer = retryAnswer(c.answer, newConfirmAnswer(c, "yes"), waitForCursorTwice)
 }
 
 // No sets "no" as the answer to the prompt.
@@ -60,71 +85,355 @@ func (c *ConfirmPrompt) No() {
 	c.lock()
 	defer c.unlock()
 
-	a := newConfirmAnswer(c, "no")
-	c.answer = a
+	c.answer = retryAnswer(c.answer, newConfirmAnswer(c, "no"), waitForCursorTwice)
 }
 
 // Answer sets a custom answer to the prompt.
 //
-// If the answer is not empty, the survey expects to have a feedback from the prompt:
+// Survey accepts "y", "yes", "n" and "no" in any case, and renders them as Yes or No. An empty answer uses the default
+// value, which renders as Yes for (Y/n) and as No for (y/N). Unless ExpectAnswered is set, the rendered answer is
+// asserted. Any other answer, "true" or "false" for example, is expected to have a feedback from the prompt, and the
+// prompt is asked again:
 //
 //	`Sorry, your reply was invalid: "hello world!" is not a valid answer, please try again.`
 //
 //	Survey.ExpectConfirm("Are you sure to delete this file?").
 //		Answer("hello world!").
+//		Answer("Y")
 func (c *ConfirmPrompt) Answer(answer string) *ConfirmAnswer {
 	c.lock()
 	defer c.unlock()
 
 	a := newConfirmAnswer(c, answer)
+	a.checkRendered = true
 
-	if answer != "" {
-		a.withFeedback(fmt.Sprintf(`Sorry, your reply was invalid: %q is not a valid answer, please try again.`, answer))
+	if answer != "" && !isConfirmAccepted(answer) {
+		a.withFeedback(validationFeedback(fmt.Sprintf(`%q is not a valid answer, please try again.`, answer)))
 	}
 
-	c.answer = a
+	c.answer = retryAnswer(c.answer, a, waitForCursorTwice)
 
 	return a
 }
 
+// AnswerFunc computes the answer from the screen when the prompt is asked. The answer is expected to be accepted,
+// such as "yes" or "no".
+//
+//	Survey.ExpectConfirmMatch(surveyexpect.Regexp(`Delete (?P<count>\d+) files\?`)).
+//		AnswerFunc(func(_ surveyexpect.Screen, captures map[string]string) string {
+//			if captures["count"] == "0" {
+//				return "no"
+//			}
+//
+//			return "yes"
+//		})
+func (c *ConfirmPrompt) AnswerFunc(fn AnswerFunc) *ConfirmAnswer {
+	c.lock()
+	defer c.unlock()
+
+	a := newConfirmAnswerFunc(c, fn)
+	c.answer = retryAnswer(c.answer, a, waitForCursorTwice)
+
+	return a
+}
+
+// Capture captures a value from the screen when the prompt is asked. The first group of the regular expression, or
+// the whole match if there is no group, is stored in dst unless it is nil, and the named groups are passed to
+// AnswerFunc.
+//
+//	Survey.ExpectConfirm("Continue?").
+//		Capture(`Found (\d+) files`, &count).
+//		Yes()
+func (c *ConfirmPrompt) Capture(pattern string, dst *string) *ConfirmPrompt {
+	c.lock()
+	defer c.unlock()
+
+	c.captureLocked(pattern, dst)
+
+	return c
+}
+
+// AnswerSequence gives a different answer each time the prompt is asked, "yes" for true and "no" for false. The prompt
+// is expected to be asked as many times as the answers.
+//
+//	Survey.ExpectConfirm("Add another host?").
+//		AnswerSequence(true, true, false)
+func (c *ConfirmPrompt) AnswerSequence(answers ...bool) *ConfirmPrompt {
+	c.lock()
+	defer c.unlock()
+
+	steps := make([]Step, 0, len(answers))
+
+	for _, answer := range answers {
+		if answer {
+			steps = append(steps, newConfirmAnswer(c, "yes"))
+		} else {
+			steps = append(steps, newConfirmAnswer(c, "no"))
+		}
+	}
+
+	c.answer = retryAnswer(c.answer, sequenceAnswers(c.basePrompt, steps...), waitForCursorTwice)
+
+	return c
+}
+
+// Press answers the prompt by sending the keys, see ParseKeys for the notation. The keys are expected to end the
+// prompt, for example with <ENTER>.
+//
+//	Survey.ExpectConfirm("Are you sure to delete this file?").
+//		Press(surveyexpect.MustParseKeys("y<ENTER>")...)
+func (c *ConfirmPrompt) Press(keys ...Key) *ConfirmPrompt {
+	c.lock()
+	defer c.unlock()
+
+	c.answer = retryAnswer(c.answer, pressKeys(keys...), waitForCursorTwice)
+
+	return c
+}
+
+// ExpectAnswered expects the answer that survey renders next to the message once the prompt is answered, Yes or No.
+// It takes precedence over the answer that Answer asserts.
+//
+//	Survey.ExpectConfirm("Subscribe to the newsletter?").
+//		ExpectAnswered("Yes").
+//		Yes()
+func (c *ConfirmPrompt) ExpectAnswered(answer string) *ConfirmPrompt {
+	c.lock()
+	defer c.unlock()
+
+	c.expectAnsweredLocked(answer)
+
+	return c
+}
+
 // Do runs the step.
 func (c *ConfirmPrompt) Do(console Console) error {
-	if _, err := console.ExpectString(c.message); err != nil {
+	if err := c.expectMessage(console); err != nil {
 		return err
 	}
 
-	_ = waitForCursorTwice(console) //nolint: errcheck
+	shown, err := c.readDefault(console)
+	if err != nil {
+		return err
+	}
+
+	// The prompt is ready for the answer once it asks for the cursor position.
+	if err := waitForCursorTwice(console); err != nil {
+		return err
+	}
+
+	if err := c.captureScreen(console); err != nil {
+		return err
+	}
 
-	err := c.answer.Do(console)
+	err = c.answer.Do(console)
 	if err != nil && !IsInterrupted(err) {
 		return err
 	}
 
+	if err == nil && !expectsRetry(c.answer) {
+		if err := c.readConfirmed(console, shown); err != nil {
+			return err
+		}
+	}
+
 	c.lock()
 	defer c.unlock()
 
-	c.repeatability--
-	c.totalCalls++
+	c.calledLocked()
+
+	return c.isDoneLocked(err)
+}
+
+// readDefault reads the default value that is shown next to the message, (Y/n) or (y/N), when it is expected or when
+// the answer is empty and takes it. It is empty otherwise.
+func (c *ConfirmPrompt) readDefault(console Console) (string, error) {
+	if c.defaultValue == nil && !lastConfirmAnswer(c.answer).takesDefault() {
+		return "", nil
+	}
+
+	rendered, err := readRenderedPrompt(console)
+	if err != nil {
+		return "", err
+	}
+
+	var actual string
+
+	if m := confirmDefaultRegex.FindStringSubmatch(rendered); m != nil {
+		actual = m[1]
+	}
+
+	if c.defaultValue == nil {
+		return actual, nil
+	}
+
+	if expected := confirmDefault(*c.defaultValue); actual != expected {
+		return "", unexpectedDefault(expected, actual)
+	}
+
+	return actual, nil
+}
+
+// readConfirmed reads the answer that survey renders once the prompt is answered. Unless another one is expected, the
+// answer that survey accepts is expected to render as Yes or No.
+func (c *ConfirmPrompt) readConfirmed(console Console, shown string) error {
+	c.lock()
+	answered := c.answered
+	text := c.matched.Text
+	c.unlock()
+
+	if answered == nil {
+		if rendered := lastConfirmAnswer(c.answer).rendered(shown); rendered != "" {
+			return expectAnswered(console, text, rendered, false)
+		}
+	}
 
-	return err
+	return c.readAnswer(console, false)
 }
 
 // String represents the expectation as a string.
 func (c *ConfirmPrompt) String() string {
 	var sb stringsBuilder
 
-	return sb.WriteLabelLinef("Expect", "Confirm Prompt").
-		WriteLabelLinef("Message", "%q", c.message).
-		WriteLabelLinef("Answer", c.answer.String()).
-		String()
+	sb.WriteLabelLinef("Expect", "Confirm Prompt").
+		WriteLabelLinef("Message", "%s", c.message)
+
+	if c.question != "" {
+		sb.WriteLabelLinef("Question", "%q", c.question)
+	}
+
+	if c.defaultValue != nil {
+		sb.WriteLabelLinef("Default", "%q", confirmDefault(*c.defaultValue))
+	}
+
+	if c.answered != nil {
+		sb.WriteLabelLinef("Answered", "%q", *c.answered)
+	}
+
+	sb.WriteLabelLinef("Answer", c.answer.String())
+
+	if calls := c.callsString(); calls != "" {
+		sb.WriteLinef("%s", calls)
+	}
+
+	return sb.String()
+}
+
+// Once indicates that the message should only be asked once.
+//
+//	Survey.ExpectConfirm("Add another host?").
+//		Once().
+//		Yes()
+func (c *ConfirmPrompt) Once() *ConfirmPrompt {
+	return c.Times(1)
+}
+
+// Twice indicates that the message should only be asked twice.
+//
+//	Survey.ExpectConfirm("Add another host?").
+//		Twice().
+//		Yes()
+func (c *ConfirmPrompt) Twice() *ConfirmPrompt {
+	return c.Times(2)
+}
+
+// Times indicates that the message should only be asked the indicated number of times.
+//
+//	Survey.ExpectConfirm("Add another host?").
+//		Times(5).
+//		Yes()
+func (c *ConfirmPrompt) Times(i int) *ConfirmPrompt {
+	c.times(i)
+
+	return c
+}
+
+// Maybe indicates that the message may be asked once, or not at all.
+//
+//	Survey.ExpectConfirm("Overwrite the existing file?").
+//		Maybe().
+//		Yes()
+func (c *ConfirmPrompt) Maybe() *ConfirmPrompt {
+	return c.AtMost(1)
+}
+
+// AtLeast indicates that the message should be asked at least the indicated number of times.
+//
+//	Survey.ExpectConfirm("Overwrite the existing file?").
+//		AtLeast(2).
+//		Yes()
+func (c *ConfirmPrompt) AtLeast(i int) *ConfirmPrompt {
+	c.cardinality(i, anyTimes)
+
+	return c
+}
+
+// AtMost indicates that the message may be asked up to the indicated number of times, or not at all.
+//
+//	Survey.ExpectConfirm("Overwrite the existing file?").
+//		AtMost(3).
+//		Yes()
+func (c *ConfirmPrompt) AtMost(i int) *ConfirmPrompt {
+	c.cardinality(0, i)
+
+	return c
+}
+
+// AnyTimes indicates that the message may be asked any number of times, or not at all.
+//
+//	Survey.ExpectConfirm("Overwrite the existing file?").
+//		AnyTimes().
+//		Yes()
+func (c *ConfirmPrompt) AnyTimes() *ConfirmPrompt {
+	c.cardinality(0, anyTimes)
+
+	return c
+}
+
+// isConfirmAccepted checks whether survey accepts the answer as yes or no.
+func isConfirmAccepted(answer string) bool {
+	return confirmYesRegex.MatchString(answer) || confirmNoRegex.MatchString(answer)
+}
+
+// lastConfirmAnswer returns the answer that is given last, when the prompt is not asked again, or nil if it is not a
+// ConfirmAnswer.
+func lastConfirmAnswer(answer Step) *ConfirmAnswer {
+	if answers, ok := answer.(*RetryAnswers); ok {
+		answer = answers.answers[len(answers.answers)-1]
+	}
+
+	a, _ := answer.(*ConfirmAnswer)
+
+	return a
+}
+
+func confirmDefault(value bool) string {
+	if value {
+		return "Y/n"
+	}
+
+	return "y/N"
+}
+
+// confirmAnswered is how survey renders the answer once the prompt is answered.
+func confirmAnswered(value bool) string {
+	if value {
+		return "Yes"
+	}
+
+	return "No"
 }
 
 // ConfirmAnswer is an answer for confirm question.
 type ConfirmAnswer struct {
 	parent      *ConfirmPrompt
 	answer      string
+	fn          AnswerFunc
 	feedback    string
 	interrupted bool
+
+	// checkRendered indicates that the answer that survey renders is asserted once it accepts the answer.
+	checkRendered bool
 }
 
 func (a *ConfirmAnswer) withFeedback(feedback string) *ConfirmAnswer {
@@ -143,17 +452,79 @@ func (a *ConfirmAnswer) Interrupted() {
 	a.feedback = ""
 }
 
+// Yes sets "yes" as the answer when the prompt is asked again after the feedback.
+//
+//	Survey.ExpectConfirm("Are you sure to delete this file?").
+//		Answer("maybe").
+//		Yes()
+func (a *ConfirmAnswer) Yes() {
+	a.parent.Yes()
+}
+
+// No sets "no" as the answer when the prompt is asked again after the feedback.
+//
+//	Survey.ExpectConfirm("Are you sure to delete this file?").
+//		Answer("maybe").
+//		No()
+func (a *ConfirmAnswer) No() {
+	a.parent.No()
+}
+
+// Answer sets a custom answer when the prompt is asked again after the feedback.
+//
+//	Survey.ExpectConfirm("Are you sure to delete this file?").
+//		Answer("maybe").
+//		Answer("perhaps").
+//		Yes()
+func (a *ConfirmAnswer) Answer(answer string) *ConfirmAnswer {
+	return a.parent.Answer(answer)
+}
+
+func (a *ConfirmAnswer) expectsRetry() bool {
+	return a.feedback != ""
+}
+
+// takesDefault checks whether the answer is empty, so survey takes the default value.
+func (a *ConfirmAnswer) takesDefault() bool {
+	return a != nil && a.checkRendered && a.fn == nil && !a.interrupted && a.answer == ""
+}
+
+// rendered returns the answer that survey renders once it accepts the answer, with the default value that is shown
+// for an empty answer. It is empty when the answer is not known beforehand.
+func (a *ConfirmAnswer) rendered(shown string) string {
+	switch {
+	case a == nil || !a.checkRendered || a.fn != nil || a.interrupted || a.feedback != "":
+		return ""
+
+	case confirmYesRegex.MatchString(a.answer):
+		return confirmAnswered(true)
+
+	case confirmNoRegex.MatchString(a.answer):
+		return confirmAnswered(false)
+
+	case a.answer == "" && shown != "":
+		return confirmAnswered(shown == confirmDefault(true))
+	}
+
+	return ""
+}
+
 // Do runs the step.
 // nolint: errcheck,gosec,nolintlint
 func (a *ConfirmAnswer) Do(c Console) error {
+	answer, err := answerOf(c, a.parent.parent, a.answer, a.fn)
+	if err != nil {
+		return err
+	}
+
 	if a.interrupted {
-		c.Send(a.answer)
+		c.Send(answer)
 		c.ExpectEOF()
 
 		return nil
 	}
 
-	c.SendLine(a.answer)
+	c.SendLine(answer)
 
 	if a.feedback != "" {
 		if _, err := c.ExpectString(a.feedback); err != nil {
@@ -168,7 +539,11 @@ func (a *ConfirmAnswer) Do(c Console) error {
 func (a *ConfirmAnswer) String() string {
 	var sb strings.Builder
 
-	_, _ = fmt.Fprintf(&sb, "%q", a.answer)
+	if a.fn != nil {
+		_, _ = sb.WriteString(computedAnswer)
+	} else {
+		_, _ = fmt.Fprintf(&sb, "%q", a.answer)
+	}
 
 	if a.interrupted {
 		_, _ = sb.WriteString(" and get interrupted")
@@ -179,14 +554,17 @@ func (a *ConfirmAnswer) String() string {
 	return sb.String()
 }
 
-func newConfirm(parent *Survey, message string) *ConfirmPrompt {
+func newConfirm(parent *Survey, message Matcher) *ConfirmPrompt {
 	return &ConfirmPrompt{
-		basePrompt: &basePrompt{
-			parent:        parent,
-			repeatability: 1,
-		},
-		message: message,
-		answer:  noAnswer(),
+		basePrompt: &basePrompt{parent: parent, message: message},
+		answer:     noAnswer(),
+	}
+}
+
+func newConfirmAnswerFunc(parent *ConfirmPrompt, fn AnswerFunc) *ConfirmAnswer {
+	return &ConfirmAnswer{
+		parent: parent,
+		fn:     fn,
 	}
 }
 
@@ -12,13 +12,24 @@ func TestConfirm_String(t *testing.T) {
 	expected := "Expect : Confirm Prompt\nMessage: \"ConfirmPrompt?\"\nAnswer : <no answer>\n"
 
 	c := &ConfirmPrompt{
-		message: "ConfirmPrompt?",
-		answer:  noAnswer(),
+		basePrompt: &basePrompt{message: Exact("ConfirmPrompt?")},
+		answer:     noAnswer(),
 	}
 
 	assert.Equal(t, expected, c.String())
 }
 
+func TestConfirm_StringWithDefault(t *testing.T) {
+	t.Parallel()
+
+	expected := "Expect : Confirm Prompt\nMessage: \"ConfirmPrompt?\"\nDefault: \"Y/n\"\nAnswer : <no answer>\n"
+
+	c := newConfirm(&Survey{}, Exact("ConfirmPrompt?")).
+		ExpectDefault(true)
+
+	assert.Equal(t, expected, c.String())
+}
+
 func TestConfirmAnswer_String(t *testing.T) {
 	t.Parallel()
 
@@ -59,3 +70,38 @@ func TestConfirmAnswer_String(t *testing.T) {
 		})
 	}
 }
+
+func TestConfirm_StringWithSequence(t *testing.T) {
+	t.Parallel()
+
+	expected := "Expect : Confirm Prompt\nMessage: \"ConfirmPrompt?\"\nAnswer : ?\n         \"maybe\" and get feedback \"Sorry, your reply was invalid: \\\"maybe\\\" is not a valid answer, please try again.\"\n         \"yes\"\n"
+
+	c := newConfirm(&Survey{}, Exact("ConfirmPrompt?")).
+		ShowHelp("This is a helpful help")
+
+	c.Answer("maybe").Yes()
+
+	assert.Equal(t, expected, c.String())
+}
+
+func TestConfirm_Times(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 1, newConfirm(&Survey{}, Exact("")).Once().repeatability)
+	assert.Equal(t, 2, newConfirm(&Survey{}, Exact("")).Twice().repeatability)
+	assert.Equal(t, 5, newConfirm(&Survey{}, Exact("")).Times(5).repeatability)
+}
+
+func TestConfirm_StringWithAnswerSequence(t *testing.T) {
+	t.Parallel()
+
+	expected := "Expect : Confirm Prompt\nMessage: \"Add another host?\"\nAnswer : #2 \"yes\", #3 \"no\"\n(called: 1 time(s), remaining: 2 time(s))\n"
+
+	c := newConfirm(&Survey{}, Exact("Add another host?")).
+		AnswerSequence(true, true, false)
+
+	c.totalCalls = 1
+	c.repeatability = 2
+
+	assert.Equal(t, expected, c.String())
+}
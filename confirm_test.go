@@ -1,6 +1,7 @@
 package surveyexpect_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -38,6 +39,14 @@ func TestConfirm(t *testing.T) {
 					Answer("")
 			}),
 		},
+		{
+			scenario: "press keys",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("ConfirmPrompt?").
+					Press("y", surveyexpect.KeyEnter)
+			}),
+			expectedAnswer: true,
+		},
 		{
 			scenario: "no answer sends an empty answer (default: true)",
 			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
@@ -55,6 +64,24 @@ func TestConfirm(t *testing.T) {
 			defaultValue:   true,
 			expectedAnswer: true,
 		},
+		{
+			scenario: "expect default (default: false)",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("ConfirmPrompt?").
+					ExpectDefault(false).
+					Yes()
+			}),
+			expectedAnswer: true,
+		},
+		{
+			scenario: "expect default (default: true)",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("ConfirmPrompt?").
+					ExpectDefault(true)
+			}),
+			defaultValue:   true,
+			expectedAnswer: true,
+		},
 		{
 			scenario: "confirm without help (yes)",
 			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
@@ -111,6 +138,17 @@ func TestConfirm(t *testing.T) {
 			help:           "This is a helpful help",
 			expectedAnswer: true,
 		},
+		{
+			scenario: "ask for help, give an invalid answer and then answer",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("ConfirmPrompt?").
+					ShowHelp("This is a helpful help").
+					Answer("maybe").
+					Yes()
+			}),
+			help:           "This is a helpful help",
+			expectedAnswer: true,
+		},
 		{
 			scenario: "input is interrupted",
 			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
@@ -181,6 +219,253 @@ func TestConfirm(t *testing.T) {
 	}
 }
 
+func TestConfirm_AnswerSequence(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectConfirm("Add another host?").
+			AnswerSequence(true, true, false)
+	})(t)
+
+	p := &survey.Confirm{Message: "Add another host?"}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answers []bool
+
+		for {
+			var answer bool
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+
+			answers = append(answers, answer)
+
+			if !answer {
+				break
+			}
+		}
+
+		assert.Equal(t, []bool{true, true, false}, answers)
+	})
+}
+
+func TestConfirm_AnswerFunc(t *testing.T) {
+	t.Parallel()
+
+	var count string
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectConfirmMatch(surveyexpect.Regexp(`Delete (?P<count>\d+) files\?`)).
+			Times(2).
+			Capture(`Found (\d+) files`, &count).
+			AnswerFunc(func(_ surveyexpect.Screen, captures map[string]string) string {
+				if captures["count"] == "0" {
+					return "no"
+				}
+
+				return "yes"
+			})
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answers []bool
+
+		for _, count := range []int{0, 3} {
+			var answer bool
+
+			_, _ = fmt.Fprintf(stdio.Out, "Found %d files\n", count) //nolint: errcheck
+
+			err := survey.AskOne(&survey.Confirm{Message: fmt.Sprintf("Delete %d files?", count)}, &answer, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+
+			answers = append(answers, answer)
+		}
+
+		assert.Equal(t, []bool{false, true}, answers)
+	})
+
+	assert.Equal(t, "3", count)
+}
+
+func TestConfirm_ExpectAnswered(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectConfirm("Subscribe to the newsletter?").
+			ExpectAnswered("Yes").
+			Yes()
+
+		s.ExpectConfirm("Share your email?").
+			ExpectAnswered("No").
+			No()
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var subscribe, share bool
+
+		err := survey.AskOne(&survey.Confirm{Message: "Subscribe to the newsletter?"}, &subscribe, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.True(t, subscribe)
+
+		err = survey.AskOne(&survey.Confirm{Message: "Share your email?"}, &share, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.False(t, share)
+	})
+}
+
+func TestConfirm_AnswerVariants(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario       string
+		answer         string
+		defaultValue   bool
+		expectedAnswer bool
+	}{
+		{scenario: "y", answer: "y", expectedAnswer: true},
+		{scenario: "Y", answer: "Y", expectedAnswer: true},
+		{scenario: "yes", answer: "yes", expectedAnswer: true},
+		{scenario: "YES", answer: "YES", expectedAnswer: true},
+		{scenario: "yEs", answer: "yEs", expectedAnswer: true},
+		{scenario: "n", answer: "n", defaultValue: true},
+		{scenario: "N", answer: "N", defaultValue: true},
+		{scenario: "no", answer: "no", defaultValue: true},
+		{scenario: "No", answer: "No", defaultValue: true},
+		{scenario: "nO", answer: "nO", defaultValue: true},
+		{scenario: "empty answer (default: true)", defaultValue: true, expectedAnswer: true},
+		{scenario: "empty answer (default: false)"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			// The rendered answer, Yes or No, is asserted for the accepted answers.
+			s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("Subscribe to the newsletter?").
+					Answer(tc.answer)
+			})(t)
+
+			p := &survey.Confirm{Message: "Subscribe to the newsletter?", Default: tc.defaultValue}
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				var answer bool
+				err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedAnswer, answer)
+			})
+		})
+	}
+}
+
+func TestConfirm_InvalidAnswerVariants(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectConfirm("Subscribe to the newsletter?").
+			Answer("true").
+			Answer("false").
+			Answer("yess").
+			Answer("Y")
+	})(t)
+
+	p := &survey.Confirm{Message: "Subscribe to the newsletter?"}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer bool
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.True(t, answer)
+	})
+}
+
+func TestConfirm_UnexpectedRenderedAnswer(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		s.ExpectConfirm("Skip the newsletter?").
+			Answer("y")
+	})(testingT)
+
+	q := []*survey.Question{{
+		Name:   "subscribe",
+		Prompt: &survey.Confirm{Message: "Skip the newsletter?"},
+		Transform: func(ans interface{}) interface{} {
+			return !ans.(bool) //nolint: forcetypeassert
+		},
+	}}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var subscribe bool
+		_ = survey.Ask(q, &subscribe, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.Equal(t, `unexpected rendered answer: expected "Yes", got "No"`, testingT.ErrorString())
+}
+
+func TestConfirm_Times(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectConfirm("Add another host?").
+			Twice().
+			Yes()
+	})(t)
+
+	p := &survey.Confirm{Message: "Add another host?"}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		for i := 0; i < 2; i++ {
+			var answer bool
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.True(t, answer)
+			assert.NoError(t, err)
+		}
+	})
+}
+
+func TestConfirm_UnexpectedDefault(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(100 * time.Millisecond)
+
+		s.ExpectConfirm("ConfirmPrompt?").
+			ExpectDefault(true).
+			Yes()
+	})(testingT)
+
+	p := &survey.Confirm{Message: "ConfirmPrompt?"}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer bool
+		_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	expectedError := `unexpected default value: expected "Y/n", got "y/N"`
+
+	assert.Contains(t, testingT.ErrorString(), expectedError)
+}
+
 func TestConfirm_NoHelpButStillExpect(t *testing.T) {
 	t.Parallel()
 
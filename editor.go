@@ -0,0 +1,430 @@
+package surveyexpect
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/Netflix/go-expect"
+)
+
+// EditorCommand is a stand-in editor for survey.Editor. Instead of opening a real editor, it announces the file that
+// survey wants to edit and waits until the EditorPrompt expectation has written the answer into that file.
+//
+// survey reads $VISUAL and $EDITOR only once when it is initialized, so the command has to be either exported before
+// running the tests, or set to survey.Editor.Editor:
+//
+//	p := &survey.Editor{
+//		Message: "Enter a commit message:",
+//		Editor:  surveyexpect.EditorCommand,
+//	}
+//
+// The command requires a POSIX shell.
+const EditorCommand = `sh -c 'printf "\033]9999;surveyexpect-editor;%s\007" "$1"; IFS= read -r _' surveyexpect-editor`
+
+var (
+	_ Prompt = (*EditorPrompt)(nil)
+	_ Answer = (*EditorAnswer)(nil)
+
+	editorFileRegex    = regexp.MustCompile("\x1b]9999;surveyexpect-editor;([^\x07]*)\x07")
+	editorDefaultRegex = regexp.MustCompile(`\((.*)\) \[Enter to launch editor]$`)
+	editorBOM          = []byte{0xef, 0xbb, 0xbf}
+)
+
+const editorLaunchHint = "[Enter to launch editor]"
+
+// EditorPrompt is an expectation of survey.Editor.
+type EditorPrompt struct {
+	*basePrompt
+
+	defaultValue *string
+	noDefault    bool
+	help         *HelpAction
+	answer       Step
+}
+
+// ShowHelp asks for help and asserts the help text before launching the editor.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		ShowHelp("A short summary of the changes").
+//		Answer("Fix typo")
+func (p *EditorPrompt) ShowHelp(help string, options ...string) *EditorPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.help = pressHelp(help, options...)
+
+	return p
+}
+
+// ExpectDefault expects the default value to be shown next to the message.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		ExpectDefault("Initial commit").
+//		Answer("Fix typo")
+func (p *EditorPrompt) ExpectDefault(value string) *EditorPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.defaultValue = &value
+	p.noDefault = false
+
+	return p
+}
+
+// ExpectNoDefault expects no default value in parentheses to be shown between the message and the launch hint, for
+// example when survey.Editor.HideDefault is set.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		ExpectNoDefault().
+//		Answer("Fix typo")
+func (p *EditorPrompt) ExpectNoDefault() *EditorPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.defaultValue = nil
+	p.noDefault = true
+
+	return p
+}
+
+// Interrupt marks the answer is interrupted.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Interrupt()
+func (p *EditorPrompt) Interrupt() {
+	p.lock()
+	defer p.unlock()
+
+	p.answer = interruptAnswer()
+	p.timesLocked(1)
+}
+
+// Answer sets the content that the editor saves.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Answer("Fix typo")
+func (p *EditorPrompt) Answer(answer string) *EditorAnswer {
+	p.lock()
+	defer p.unlock()
+
+	a := newEditorAnswer(p)
+	a.answer = &answer
+	p.answer = a
+
+	return a
+}
+
+// AnswerSequence sets a different content that the editor saves each time the prompt is asked, the first answer for
+// the first time, the second one for the second time and so on. The prompt is expected to be asked as many times as
+// the answers.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		AnswerSequence("Fix typo", "Add tests")
+func (p *EditorPrompt) AnswerSequence(answers ...string) *EditorPrompt {
+	p.lock()
+	defer p.unlock()
+
+	steps := make([]Step, 0, len(answers))
+
+	for _, answer := range answers {
+		answer := answer
+		a := newEditorAnswer(p)
+		a.answer = &answer
+
+		steps = append(steps, a)
+	}
+
+	p.answer = sequenceAnswers(p.basePrompt, steps...)
+
+	return p
+}
+
+// Press sends the keys instead of launching the editor, see ParseKeys for the notation. The keys are expected to end
+// the prompt.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Press(surveyexpect.KeyCtrlC)
+func (p *EditorPrompt) Press(keys ...Key) *EditorPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.answer = pressKeys(keys...)
+
+	return p
+}
+
+// ExpectAnswered expects the answer that survey renders next to the message once the prompt is answered. Survey
+// renders <Received> instead of the content of the editor.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		ExpectAnswered("<Received>").
+//		Answer("Fix typo")
+func (p *EditorPrompt) ExpectAnswered(answer string) *EditorPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.expectAnsweredLocked(answer)
+
+	return p
+}
+
+// Do runs the step.
+func (p *EditorPrompt) Do(c Console) error {
+	if err := p.expectMessage(c); err != nil {
+		return err
+	}
+
+	if err := p.expectDefault(c); err != nil {
+		return err
+	}
+
+	if p.help != nil {
+		if err := p.help.Do(c); err != nil {
+			return err
+		}
+	}
+
+	err := p.answer.Do(c)
+	if err != nil && !IsInterrupted(err) {
+		return err
+	}
+
+	if err == nil && !expectsRetry(p.answer) {
+		if err := p.readAnswer(c, false); err != nil {
+			return err
+		}
+	}
+
+	p.lock()
+	defer p.unlock()
+
+	p.calledLocked()
+
+	return p.isDoneLocked(err)
+}
+
+// expectDefault reads the prompt until the launch hint and checks the default value in between.
+func (p *EditorPrompt) expectDefault(c Console) error {
+	buf, err := c.ExpectString(editorLaunchHint)
+	if err != nil {
+		return err
+	}
+
+	m := editorDefaultRegex.FindStringSubmatch(buf)
+
+	if p.noDefault && m != nil {
+		return fmt.Errorf("%w: expected no default, got %q", ErrUnexpectedDefault, m[1])
+	}
+
+	if p.defaultValue == nil {
+		return nil
+	}
+
+	var actual string
+
+	if m != nil {
+		actual = m[1]
+	}
+
+	if actual != *p.defaultValue {
+		return unexpectedDefault(*p.defaultValue, actual)
+	}
+
+	return nil
+}
+
+// String represents the expectation as a string.
+func (p *EditorPrompt) String() string {
+	var sb stringsBuilder
+
+	sb.WriteLabelLinef("Expect", "Editor Prompt").
+		WriteLabelLinef("Message", "%s", p.message)
+
+	if p.question != "" {
+		sb.WriteLabelLinef("Question", "%q", p.question)
+	}
+
+	if p.defaultValue != nil {
+		sb.WriteLabelLinef("Default", "%q", *p.defaultValue)
+	} else if p.noDefault {
+		sb.WriteLabelLinef("Default", "<none>")
+	}
+
+	if p.help != nil {
+		sb.WriteLabelLinef("Help", "%q", p.help.help)
+	}
+
+	if p.answered != nil {
+		sb.WriteLabelLinef("Answered", "%q", *p.answered)
+	}
+
+	sb.WriteLabelLinef("Answer", p.answer.String())
+
+	if calls := p.callsString(); calls != "" {
+		sb.WriteLinef("%s", calls)
+	}
+
+	return sb.String()
+}
+
+// Once indicates that the message should only be asked once.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Answer("Fix typo").
+//		Once()
+func (p *EditorPrompt) Once() *EditorPrompt {
+	return p.Times(1)
+}
+
+// Twice indicates that the message should only be asked twice.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Answer("Fix typo").
+//		Twice()
+func (p *EditorPrompt) Twice() *EditorPrompt {
+	return p.Times(2)
+}
+
+// Times indicates that the message should only be asked the indicated number of times.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Answer("Fix typo").
+//		Times(5)
+func (p *EditorPrompt) Times(i int) *EditorPrompt {
+	p.times(i)
+
+	return p
+}
+
+// Maybe indicates that the message may be asked once, or not at all.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Answer("Fix typo").
+//		Maybe()
+func (p *EditorPrompt) Maybe() *EditorPrompt {
+	return p.AtMost(1)
+}
+
+// AtLeast indicates that the message should be asked at least the indicated number of times.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Answer("Fix typo").
+//		AtLeast(2)
+func (p *EditorPrompt) AtLeast(i int) *EditorPrompt {
+	p.cardinality(i, anyTimes)
+
+	return p
+}
+
+// AtMost indicates that the message may be asked up to the indicated number of times, or not at all.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Answer("Fix typo").
+//		AtMost(3)
+func (p *EditorPrompt) AtMost(i int) *EditorPrompt {
+	p.cardinality(0, i)
+
+	return p
+}
+
+// AnyTimes indicates that the message may be asked any number of times, or not at all.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Answer("Fix typo").
+//		AnyTimes()
+func (p *EditorPrompt) AnyTimes() *EditorPrompt {
+	p.cardinality(0, anyTimes)
+
+	return p
+}
+
+// EditorAnswer is an answer for editor question.
+type EditorAnswer struct {
+	parent  *EditorPrompt
+	answer  *string
+	content *string
+}
+
+// ExpectContent expects the content that survey passes to the editor, for example the default value when
+// survey.Editor.AppendDefault is set.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Answer("Fix typo").
+//		ExpectContent("Initial commit")
+func (a *EditorAnswer) ExpectContent(content string) *EditorAnswer {
+	a.parent.lock()
+	defer a.parent.unlock()
+
+	a.content = &content
+
+	return a
+}
+
+// Do runs the step.
+// nolint: errcheck,gosec,nolintlint
+func (a *EditorAnswer) Do(c Console) error {
+	c.SendLine("")
+
+	buf, err := c.Expect(expect.Regexp(editorFileRegex))
+	if err != nil {
+		return err
+	}
+
+	// The editor waits for a new line, release it no matter what happens.
+	defer c.SendLine("")
+
+	m := editorFileRegex.FindStringSubmatch(buf)
+	file := m[1]
+
+	if a.content != nil {
+		raw, err := os.ReadFile(file) //nolint: gosec
+		if err != nil {
+			return err
+		}
+
+		if actual := string(bytes.TrimPrefix(raw, editorBOM)); actual != *a.content {
+			return fmt.Errorf("%w: expected %q, got %q", ErrUnexpectedEditorContent, *a.content, actual)
+		}
+	}
+
+	if a.answer == nil {
+		return nil
+	}
+
+	return os.WriteFile(file, []byte(*a.answer), 0o600)
+}
+
+// String represents the answer as a string.
+func (a *EditorAnswer) String() string {
+	var sb stringsBuilder
+
+	if a.answer == nil {
+		sb.WriteString("<no change>")
+	} else {
+		sb.Writef("%q", *a.answer)
+	}
+
+	if a.content != nil {
+		sb.Writef(" and expect content %q", *a.content)
+	}
+
+	return sb.String()
+}
+
+func newEditor(parent *Survey, message Matcher) *EditorPrompt {
+	p := &EditorPrompt{
+		basePrompt: &basePrompt{parent: parent, message: message},
+	}
+
+	p.answer = newEditorAnswer(p)
+
+	return p
+}
+
+func newEditorAnswer(parent *EditorPrompt) *EditorAnswer {
+	return &EditorAnswer{
+		parent: parent,
+	}
+}
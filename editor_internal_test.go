@@ -0,0 +1,81 @@
+package surveyexpect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditorPrompt_Once(t *testing.T) {
+	t.Parallel()
+
+	p := newEditor(&Survey{}, Exact("")).Once()
+
+	assert.Equal(t, 1, p.repeatability)
+}
+
+func TestEditorPrompt_Twice(t *testing.T) {
+	t.Parallel()
+
+	p := newEditor(&Survey{}, Exact("")).Twice()
+
+	assert.Equal(t, 2, p.repeatability)
+}
+
+func TestEditorPrompt_Times(t *testing.T) {
+	t.Parallel()
+
+	p := newEditor(&Survey{}, Exact("")).Times(5)
+
+	assert.Equal(t, 5, p.repeatability)
+}
+
+func TestEditorPrompt_String(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario      string
+		mock          func(p *EditorPrompt)
+		repeatability int
+		totalCalls    int
+		expected      string
+	}{
+		{
+			scenario: "no answer",
+			expected: "Expect : Editor Prompt\nMessage: \"Enter a message:\"\nAnswer : <no change>\n",
+		},
+		{
+			scenario: "with default and help",
+			mock: func(p *EditorPrompt) {
+				p.ExpectDefault("hello").
+					ShowHelp("some help").
+					Answer("world").
+					ExpectContent("hello")
+			},
+			expected: "Expect : Editor Prompt\nMessage: \"Enter a message:\"\nDefault: \"hello\"\nHelp   : \"some help\"\nAnswer : \"world\" and expect content \"hello\"\n",
+		},
+		{
+			scenario:      "repeat > 0",
+			repeatability: 3,
+			totalCalls:    1,
+			expected:      "Expect : Editor Prompt\nMessage: \"Enter a message:\"\nAnswer : <no change>\n(called: 1 time(s), remaining: 3 time(s))\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			p := newEditor(&Survey{}, Exact("Enter a message:"))
+			p.repeatability = tc.repeatability
+			p.totalCalls = tc.totalCalls
+
+			if tc.mock != nil {
+				tc.mock(p)
+			}
+
+			assert.Equal(t, tc.expected, p.String())
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package surveyexpect_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/stretchr/testify/assert"
+
+	"go.nhat.io/surveyexpect"
+	"go.nhat.io/surveyexpect/options"
+)
+
+func TestEditorPrompt(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario       string
+		expectSurvey   surveyexpect.Expector
+		defaultValue   string
+		hideDefault    bool
+		appendDefault  bool
+		help           string
+		expectedAnswer string
+		expectedError  string
+	}{
+		{
+			scenario: "no answer keeps the file unchanged",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectEditor("Enter a commit message")
+			}),
+		},
+		{
+			scenario: "answer",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectEditor("Enter a commit message").
+					Answer("Fix typo\n\nThe word was misspelled.")
+			}),
+			expectedAnswer: "Fix typo\n\nThe word was misspelled.",
+		},
+		{
+			scenario: "no answer uses the default",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectEditor("Enter a commit message").
+					ExpectDefault("Initial commit").
+					Answer("").
+					ExpectContent("")
+			}),
+			defaultValue:   "Initial commit",
+			expectedAnswer: "Initial commit",
+		},
+		{
+			scenario: "default with parentheses",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectEditor("Enter a commit message").
+					ExpectDefault("Fix (typo)").
+					Answer("")
+			}),
+			defaultValue:   "Fix (typo)",
+			expectedAnswer: "Fix (typo)",
+		},
+		{
+			scenario: "hide default",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectEditor("Enter a commit message").
+					ExpectNoDefault().
+					Answer("Fix typo")
+			}),
+			defaultValue:   "Initial commit",
+			hideDefault:    true,
+			expectedAnswer: "Fix typo",
+		},
+		{
+			scenario: "append default",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectEditor("Enter a commit message").
+					ExpectDefault("Initial commit").
+					Answer("Initial commit\n\nAdd README").
+					ExpectContent("Initial commit")
+			}),
+			defaultValue:   "Initial commit",
+			appendDefault:  true,
+			expectedAnswer: "Initial commit\n\nAdd README",
+		},
+		{
+			scenario: "ask for help",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectEditor("Enter a commit message [? for help]").
+					ShowHelp("A short summary of the changes").
+					Answer("Fix typo")
+			}),
+			help:           "A short summary of the changes",
+			expectedAnswer: "Fix typo",
+		},
+		{
+			scenario: "interrupted",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectEditor("Enter a commit message").
+					Interrupt()
+			}),
+			expectedError: "interrupt",
+		},
+		{
+			scenario: "press keys",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectEditor("Enter a commit message").
+					Press(surveyexpect.KeyCtrlC)
+			}),
+			expectedError: "interrupt",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			// Prepare the survey.
+			s := tc.expectSurvey(t)
+			p := &survey.Editor{
+				Message:       "Enter a commit message",
+				Default:       tc.defaultValue,
+				Help:          tc.help,
+				Editor:        surveyexpect.EditorCommand,
+				HideDefault:   tc.hideDefault,
+				AppendDefault: tc.appendDefault,
+			}
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				var answer string
+				err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+				assert.Equal(t, tc.expectedAnswer, answer)
+
+				if tc.expectedError == "" {
+					assert.NoError(t, err)
+				} else {
+					assert.EqualError(t, err, tc.expectedError)
+				}
+			})
+		})
+	}
+}
+
+func TestEditorPrompt_Times(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectEditor("Enter a commit message").
+			Twice().
+			Answer("Fix typo")
+	})(t)
+
+	p := &survey.Editor{
+		Message: "Enter a commit message",
+		Editor:  surveyexpect.EditorCommand,
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		for i := 0; i < 2; i++ {
+			var answer string
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.Equal(t, "Fix typo", answer)
+			assert.NoError(t, err)
+		}
+	})
+}
+
+func TestEditorPrompt_ExpectAnswered(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectEditor("Enter a commit message").
+			ExpectAnswered("<Received>").
+			Answer("Fix typo")
+	})(t)
+
+	p := &survey.Editor{
+		Message: "Enter a commit message",
+		Editor:  surveyexpect.EditorCommand,
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Fix typo", answer)
+	})
+}
+
+func TestEditorPrompt_AnswerSequence(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectEditor("Enter a commit message").
+			AnswerSequence("Fix typo", "Add tests")
+	})(t)
+
+	p := &survey.Editor{
+		Message: "Enter a commit message",
+		Editor:  surveyexpect.EditorCommand,
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answers []string
+
+		for i := 0; i < 2; i++ {
+			var answer string
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+
+			answers = append(answers, answer)
+		}
+
+		assert.Equal(t, []string{"Fix typo", "Add tests"}, answers)
+	})
+}
+
+func TestEditorPrompt_UnexpectedDefault(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario      string
+		expectSurvey  surveyexpect.Expector
+		expectedError string
+	}{
+		{
+			scenario: "default",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(100 * time.Millisecond)
+
+				s.ExpectEditor("Enter a commit message").
+					ExpectDefault("Initial commit").
+					Answer("Fix typo")
+			}),
+			expectedError: `unexpected default value: expected "Initial commit", got "First commit"`,
+		},
+		{
+			scenario: "no default",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(100 * time.Millisecond)
+
+				s.ExpectEditor("Enter a commit message").
+					ExpectNoDefault().
+					Answer("Fix typo")
+			}),
+			expectedError: `unexpected default value: expected no default, got "First commit"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			testingT := T()
+			s := tc.expectSurvey(testingT)
+
+			p := &survey.Editor{
+				Message: "Enter a commit message",
+				Default: "First commit",
+				Editor:  surveyexpect.EditorCommand,
+			}
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				var answer string
+				_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+			})
+
+			assert.Contains(t, testingT.ErrorString(), tc.expectedError)
+		})
+	}
+}
+
+func TestEditorPrompt_UnexpectedContent(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		s.ExpectEditor("Enter a commit message").
+			Answer("Fix typo").
+			ExpectContent("Initial commit")
+	})(testingT)
+
+	p := &survey.Editor{
+		Message: "Enter a commit message",
+		Editor:  surveyexpect.EditorCommand,
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.Empty(t, answer)
+		assert.NoError(t, err)
+	})
+
+	expectedError := `unexpected editor content: expected "Initial commit", got ""`
+
+	assert.Equal(t, expectedError, testingT.ErrorString())
+}
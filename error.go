@@ -2,6 +2,7 @@ package surveyexpect
 
 import (
 	"errors"
+	"os"
 
 	"github.com/AlecAivazis/survey/v2/terminal"
 )
@@ -13,6 +14,48 @@ var (
 	ErrNotFinished = errors.New("step is not finished")
 	// ErrSequenceClosed indicates that the step is closed and does not take more action.
 	ErrSequenceClosed = errors.New("sequence is closed")
+	// ErrUnexpectedDefault indicates that the prompt does not show the expected default value.
+	ErrUnexpectedDefault = errors.New("unexpected default value")
+	// ErrUnexpectedEditorContent indicates that survey does not pass the expected content to the editor.
+	ErrUnexpectedEditorContent = errors.New("unexpected editor content")
+	// ErrScreenUnavailable indicates that the console does not render to a virtual terminal.
+	ErrScreenUnavailable = errors.New("screen is not available")
+	// ErrOptionNotFound indicates that the option is not in the list.
+	ErrOptionNotFound = errors.New("option not found")
+	// ErrUnexpectedHighlight indicates that the list does not highlight the expected option.
+	ErrUnexpectedHighlight = errors.New("unexpected highlighted option")
+	// ErrUnexpectedOptions indicates that the list does not render the expected options.
+	ErrUnexpectedOptions = errors.New("unexpected options")
+	// ErrUnexpectedFilter indicates that the list is not filtered by the expected text.
+	ErrUnexpectedFilter = errors.New("unexpected filter")
+	// ErrUnexpectedCheckedState indicates that the option is not checked or unchecked as expected.
+	ErrUnexpectedCheckedState = errors.New("unexpected checked state")
+	// ErrUnexpectedVimMode indicates that the vim mode of the list is not enabled or disabled as expected.
+	ErrUnexpectedVimMode = errors.New("unexpected vim mode")
+	// ErrVimModeUnknown indicates that the vim mode of the list cannot be found out.
+	ErrVimModeUnknown = errors.New("vim mode is unknown")
+	// ErrUnexpectedBuffer indicates that the input prompt does not show the expected text being edited.
+	ErrUnexpectedBuffer = errors.New("unexpected buffer")
+	// ErrUnexpectedCursor indicates that the cursor is not at the expected position.
+	ErrUnexpectedCursor = errors.New("unexpected cursor position")
+	// ErrUnexpectedText indicates that the multiline prompt does not show the expected text being entered.
+	ErrUnexpectedText = errors.New("unexpected text")
+	// ErrUnexpectedAnswer indicates that survey does not render the expected answer once the prompt is answered.
+	ErrUnexpectedAnswer = errors.New("unexpected rendered answer")
+	// ErrUnexpectedFormAnswer indicates that survey does not write the expected answer of a question of a form.
+	ErrUnexpectedFormAnswer = errors.New("unexpected form answer")
+	// ErrInvalidQuestion indicates that the question is not in the form or it is not of the expected prompt type.
+	ErrInvalidQuestion = errors.New("invalid question")
+	// ErrPlaintextShown indicates that an answer which is expected to be hidden shows up in plain text in the output.
+	ErrPlaintextShown = errors.New("plaintext is shown")
+	// ErrNoMoreAnswers indicates that the prompt is asked more times than the answers in the sequence.
+	ErrNoMoreAnswers = errors.New("no more answers")
+	// ErrNothingCaptured indicates that the screen does not show the value to capture.
+	ErrNothingCaptured = errors.New("nothing is captured")
+	// ErrNoBranchAsked indicates that none of the expected branches is asked.
+	ErrNoBranchAsked = errors.New("none of the branches is asked")
+	// ErrInvalidKeyNotation indicates that the notation of a sequence of keys cannot be parsed.
+	ErrInvalidKeyNotation = errors.New("invalid key notation")
 )
 
 // IsIgnoredError checks whether the error is ignored.
@@ -34,6 +77,11 @@ func IsNothingTodo(err error) bool {
 	return errors.Is(err, ErrNothingToDo)
 }
 
+// isTimeout checks if the error is caused by the read deadline of the console or not.
+func isTimeout(err error) bool {
+	return errors.Is(err, os.ErrDeadlineExceeded)
+}
+
 func mustNotClosed(closed bool) {
 	if closed {
 		panic(ErrSequenceClosed)
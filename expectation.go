@@ -3,6 +3,7 @@ package surveyexpect
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 var (
@@ -70,6 +71,421 @@ func expectMultiSelect(options ...string) *MultiSelectExpect {
 	return &e
 }
 
+// OptionWithDescription is an option of a select or multiselect list with its description.
+type OptionWithDescription struct {
+	Option      string
+	Description string
+	// Highlighted expects the option to be highlighted.
+	Highlighted bool
+	// Checked expects the option to be checked, for a multiselect list only.
+	Checked bool
+}
+
+// String represents the option as it is rendered.
+func (o OptionWithDescription) String() string {
+	if o.Description == "" {
+		return o.Option
+	}
+
+	return o.Option + descriptionSeparator + o.Description
+}
+
+func (o OptionWithDescription) rendered(multiselect bool) renderedOption {
+	return renderedOption{
+		value:       o.String(),
+		highlighted: o.Highlighted,
+		checked:     multiselect && o.Checked,
+	}
+}
+
+// DescribedOptionsExpect expects exactly the visible options with their descriptions from the screen, in order,
+// including the highlighted option and the checked state.
+type DescribedOptionsExpect struct {
+	options     []OptionWithDescription
+	multiselect bool
+}
+
+// Do runs the step.
+func (e *DescribedOptionsExpect) Do(c Console) error {
+	options, err := readOptions(c, e.multiselect)
+	if err != nil {
+		return err
+	}
+
+	if actual := formatOptions(options, e.multiselect); actual != e.list() {
+		return fmt.Errorf("%w: expected:\n%s\ngot:\n%s", ErrUnexpectedOptions, e.list(), actual)
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (e *DescribedOptionsExpect) String() string {
+	var sb stringsBuilder
+
+	if e.multiselect {
+		sb.WriteLinef("Expect a multiselect list:")
+	} else {
+		sb.WriteLinef("Expect a select list:")
+	}
+
+	sb.WriteString(e.list())
+
+	return sb.String()
+}
+
+func (e *DescribedOptionsExpect) list() string {
+	options := make([]renderedOption, 0, len(e.options))
+
+	for _, o := range e.options {
+		options = append(options, o.rendered(e.multiselect))
+	}
+
+	return formatOptions(options, e.multiselect)
+}
+
+// expectOptionsWithDescription also makes the descriptions known, so the options are found by their labels.
+func expectOptionsWithDescription(multiselect bool, texts *optionTexts, options ...OptionWithDescription) *DescribedOptionsExpect {
+	descriptions := make(map[string]string, len(options))
+
+	for _, o := range options {
+		if o.Description != "" {
+			descriptions[o.Option] = o.Description
+		}
+	}
+
+	texts.describe(descriptions)
+
+	return &DescribedOptionsExpect{
+		options:     options,
+		multiselect: multiselect,
+	}
+}
+
+// ExactOptionsExpect expects the exact list of visible options from the screen, in order, including the highlighted
+// option and the checked state.
+type ExactOptionsExpect struct {
+	options     []string
+	multiselect bool
+}
+
+// Do runs the step.
+func (e *ExactOptionsExpect) Do(c Console) error {
+	options, err := readOptions(c, e.multiselect)
+	if err != nil {
+		return err
+	}
+
+	indicator := selectIndicatorRegex
+
+	if e.multiselect {
+		indicator = multiselectIndicatorRegex
+	}
+
+	expected := make([]string, 0, len(e.options))
+
+	for _, o := range e.options {
+		if m := indicator.FindStringSubmatch(o); m != nil {
+			expected = append(expected, selectFocusIcon+m[2])
+		} else {
+			expected = append(expected, selectBlurIcon+o)
+		}
+	}
+
+	if actual := formatOptions(options, e.multiselect); actual != strings.Join(expected, "\n") {
+		return fmt.Errorf("%w: expected:\n%s\ngot:\n%s", ErrUnexpectedOptions, strings.Join(expected, "\n"), actual)
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (e *ExactOptionsExpect) String() string {
+	var sb stringsBuilder
+
+	if e.multiselect {
+		sb.WriteLinef("Expect exactly a multiselect list:")
+		writeSelectList(&sb, e.options, multiselectIndicatorRegex)
+	} else {
+		sb.WriteLinef("Expect exactly a select list:")
+		writeSelectList(&sb, e.options, selectIndicatorRegex)
+	}
+
+	return sb.String()
+}
+
+func expectExactOptions(multiselect bool, options ...string) *ExactOptionsExpect {
+	return &ExactOptionsExpect{
+		options:     options,
+		multiselect: multiselect,
+	}
+}
+
+// WindowExpect expects the window of a list that is visible, which is the index of the first visible option and the
+// number of visible options.
+type WindowExpect struct {
+	start       int
+	size        int
+	multiselect bool
+}
+
+// Do runs the step.
+func (e *WindowExpect) Do(c Console) error {
+	options, start, err := visibleStart(c, e.multiselect)
+	if err != nil {
+		return err
+	}
+
+	if start != e.start || len(options) != e.size {
+		return fmt.Errorf("%w: expected %d visible options from #%d, got %d from #%d:\n%s",
+			ErrUnexpectedOptions, e.size, e.start, len(options), start, formatOptions(options, e.multiselect),
+		)
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (e *WindowExpect) String() string {
+	return fmt.Sprintf("Expect %d visible options from #%d", e.size, e.start)
+}
+
+func expectWindow(multiselect bool, start, size int) *WindowExpect {
+	return &WindowExpect{
+		start:       start,
+		size:        size,
+		multiselect: multiselect,
+	}
+}
+
+// VisibleOptionsExpect expects exactly the visible options, in order, regardless of the highlighted option and the
+// checked state.
+type VisibleOptionsExpect struct {
+	options     []string
+	texts       *optionTexts
+	multiselect bool
+}
+
+// Do runs the step.
+func (e *VisibleOptionsExpect) Do(c Console) error {
+	options, err := readOptions(c, e.multiselect)
+	if err != nil {
+		return err
+	}
+
+	actual := make([]string, 0, len(options))
+
+	for _, o := range options {
+		actual = append(actual, o.value)
+	}
+
+	if !e.texts.matchLabels(actual, e.options, true) {
+		return fmt.Errorf("%w: expected visible options %q, got:\n%s", ErrUnexpectedOptions, e.options, formatOptions(options, e.multiselect))
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (e *VisibleOptionsExpect) String() string {
+	return fmt.Sprintf("Expect visible options: %q", e.options)
+}
+
+func expectVisibleOptions(multiselect bool, texts *optionTexts, options ...string) *VisibleOptionsExpect {
+	return &VisibleOptionsExpect{
+		options:     options,
+		texts:       texts,
+		multiselect: multiselect,
+	}
+}
+
+// HighlightedExpect expects the highlighted option from the screen.
+type HighlightedExpect struct {
+	option      string
+	texts       *optionTexts
+	multiselect bool
+}
+
+// Do runs the step.
+func (e *HighlightedExpect) Do(c Console) error {
+	_, err := expectHighlighted(c, e.texts, e.option, e.multiselect)
+
+	return err
+}
+
+// String represents the answer as a string.
+func (e *HighlightedExpect) String() string {
+	return fmt.Sprintf("Expect highlighted option: %q", e.option)
+}
+
+func expectHighlightedOption(multiselect bool, texts *optionTexts, option string) *HighlightedExpect {
+	return &HighlightedExpect{
+		option:      option,
+		texts:       texts,
+		multiselect: multiselect,
+	}
+}
+
+// CheckedExpect expects the checked options of the visible multiselect list from the screen, regardless of the order.
+type CheckedExpect struct {
+	options []string
+	texts   *optionTexts
+}
+
+// Do runs the step.
+func (e *CheckedExpect) Do(c Console) error {
+	options, err := readOptions(c, true)
+	if err != nil {
+		return err
+	}
+
+	if !e.texts.matchLabels(checkedOptions(options), e.options, false) {
+		return fmt.Errorf("%w: expected %q to be checked, got:\n%s", ErrUnexpectedCheckedState, e.options, formatOptions(options, true))
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (e *CheckedExpect) String() string {
+	return fmt.Sprintf("Expect checked options: %q", e.options)
+}
+
+func expectChecked(texts *optionTexts, options ...string) *CheckedExpect {
+	return &CheckedExpect{
+		options: options,
+		texts:   texts,
+	}
+}
+
+// FilterExpect expects the filter that is rendered next to the message.
+type FilterExpect struct {
+	message func() string
+	filter  string
+}
+
+// Do runs the step.
+func (e *FilterExpect) Do(c Console) error {
+	l, err := readOptionList(c, false)
+	if err != nil {
+		return err
+	}
+
+	if actual := l.filter(e.message()); actual != e.filter {
+		return fmt.Errorf("%w: expected %q, got %q", ErrUnexpectedFilter, e.filter, actual)
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (e *FilterExpect) String() string {
+	return fmt.Sprintf("Expect filter: %q", e.filter)
+}
+
+func expectFilter(message func() string, filter string) *FilterExpect {
+	return &FilterExpect{
+		message: message,
+		filter:  filter,
+	}
+}
+
+// BufferExpect expects the text that is being edited in an input prompt.
+type BufferExpect struct {
+	message func() string
+	text    string
+}
+
+// Do runs the step.
+func (e *BufferExpect) Do(c Console) error {
+	l, err := readInputLine(c, e.message())
+	if err != nil {
+		return err
+	}
+
+	if l.text != e.text {
+		return fmt.Errorf("%w: expected %q, got %q", ErrUnexpectedBuffer, e.text, l.text)
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (e *BufferExpect) String() string {
+	return fmt.Sprintf("Expect buffer: %q", e.text)
+}
+
+func expectBuffer(message func() string, text string) *BufferExpect {
+	return &BufferExpect{
+		message: message,
+		text:    text,
+	}
+}
+
+// CursorExpect expects the position of the cursor in the text that is being edited in an input prompt.
+type CursorExpect struct {
+	message  func() string
+	position int
+}
+
+// Do runs the step.
+func (e *CursorExpect) Do(c Console) error {
+	l, err := readInputLine(c, e.message())
+	if err != nil {
+		return err
+	}
+
+	if l.cursor != e.position {
+		return fmt.Errorf("%w: expected %d, got %d in %q", ErrUnexpectedCursor, e.position, l.cursor, l.text)
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (e *CursorExpect) String() string {
+	return fmt.Sprintf("Expect cursor at: %d", e.position)
+}
+
+func expectCursor(message func() string, position int) *CursorExpect {
+	return &CursorExpect{
+		message:  message,
+		position: position,
+	}
+}
+
+// TextExpect expects the text that is entered in a multiline prompt.
+type TextExpect struct {
+	message func() string
+	text    string
+}
+
+// Do runs the step.
+func (e *TextExpect) Do(c Console) error {
+	text, err := readMultilineText(c, e.message())
+	if err != nil {
+		return err
+	}
+
+	if text != e.text {
+		return fmt.Errorf("%w: expected %q, got %q", ErrUnexpectedText, e.text, text)
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (e *TextExpect) String() string {
+	return fmt.Sprintf("Expect text: %q", e.text)
+}
+
+func expectText(message func() string, text string) *TextExpect {
+	return &TextExpect{
+		message: message,
+		text:    text,
+	}
+}
+
 func breakdownOptions(options []string, indicator *regexp.Regexp) ([]map[string]string, string) {
 	breakdown := make([]map[string]string, 0, len(options))
 
@@ -130,3 +546,61 @@ func (e StringExpect) String() string {
 func expectString(s string) StringExpect {
 	return StringExpect(s)
 }
+
+// MessageExpect expects the text that matched the message of the prompt when it was asked.
+type MessageExpect struct {
+	message Matcher
+	text    func() string
+}
+
+// Do runs the step.
+func (e *MessageExpect) Do(c Console) error {
+	_, err := c.ExpectString(e.text())
+
+	return err
+}
+
+// String represents the answer as a string.
+func (e *MessageExpect) String() string {
+	return fmt.Sprintf("Expect a string: %s", e.message)
+}
+
+func expectMessageAgain(message Matcher, text func() string) *MessageExpect {
+	return &MessageExpect{
+		message: message,
+		text:    text,
+	}
+}
+
+// ValidationErrorExpect expects a validation error from console.
+type ValidationErrorExpect string
+
+// Do runs the step.
+func (e ValidationErrorExpect) Do(c Console) error {
+	_, err := c.ExpectString(validationFeedback(string(e)))
+
+	return err
+}
+
+// String represents the answer as a string.
+func (e ValidationErrorExpect) String() string {
+	return fmt.Sprintf("Expect a validation error: %q", string(e))
+}
+
+func expectValidationError(err string) ValidationErrorExpect {
+	return ValidationErrorExpect(err)
+}
+
+// readRenderedPrompt reads the rest of the rendered prompt, until the next escape sequence.
+func readRenderedPrompt(c Console) (string, error) {
+	buf, err := c.ExpectString("\x1b")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf, "\x1b"), nil
+}
+
+func unexpectedDefault(expected, actual interface{}) error {
+	return fmt.Errorf("%w: expected %q, got %q", ErrUnexpectedDefault, expected, actual)
+}
@@ -0,0 +1,174 @@
+package surveyexpect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExactOptionsExpect_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Expect exactly a select list:\n> France\n  Germany", expectExactOptions(false, "> France", "Germany").String())
+	assert.Equal(t, "Expect exactly a multiselect list:\n> [x]  France\n  [ ]  Germany", expectExactOptions(true, "> [x]  France", "[ ]  Germany").String())
+}
+
+func TestListExpect_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `Expect highlighted option: "France"`, expectHighlightedOption(false, nil, "France").String())
+	assert.Equal(t, `Expect checked options: ["France" "Germany"]`, expectChecked(nil, "France", "Germany").String())
+	assert.Equal(t, `Expect filter: "Fr"`, expectFilter(staticText("Select a country"), "Fr").String())
+}
+
+func TestOptionList_Filter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario string
+		message  string
+		lines    []string
+		expected string
+	}{
+		{
+			scenario: "select",
+			message:  "Select a country",
+			lines: []string{
+				"? Select a country Fr  [Use arrows to move, type to filter]",
+				"> France",
+			},
+			expected: "Fr",
+		},
+		{
+			scenario: "no filter",
+			message:  "Select a country",
+			lines: []string{
+				"? Select a country  [Use arrows to move, type to filter]",
+				"> France",
+			},
+		},
+		{
+			scenario: "wrapped question",
+			message:  "Select destinations",
+			lines: []string{
+				"? Select destinations Ger  [Use arrows to move, space to select, <right> to all, <",
+				"left> to none, type to filter]",
+				"> [ ]  Germany",
+			},
+			expected: "Ger",
+		},
+		{
+			scenario: "suggestions",
+			message:  "Enter username:",
+			lines: []string{
+				"? Enter username: john.doe [Use arrows to move, enter to select, type to continue]",
+				"> john.doe",
+				"  john.lennon",
+			},
+			expected: "john.doe",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			l := parseOptionList(tc.lines, false)
+
+			assert.Equal(t, tc.expected, l.filter(tc.message))
+		})
+	}
+}
+
+func TestPaginationExpect_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Expect 5 visible options from #0", expectWindow(false, 0, 5).String())
+	assert.Equal(t, `Expect visible options: ["France" "Germany"]`, expectVisibleOptions(false, nil, "France", "Germany").String())
+}
+
+func TestDescribedOptionsExpect_String(t *testing.T) {
+	t.Parallel()
+
+	e := expectOptionsWithDescription(true, newOptionTexts(),
+		OptionWithDescription{Option: "eu-west-1", Description: "Ireland", Highlighted: true, Checked: true},
+		OptionWithDescription{Option: "us-east-1"},
+	)
+
+	assert.Equal(t, "Expect a multiselect list:\n> [x]  eu-west-1 - Ireland\n  [ ]  us-east-1", e.String())
+}
+
+func TestOptionTexts_MatchLabels(t *testing.T) {
+	t.Parallel()
+
+	texts := newOptionTexts()
+	texts.describe(map[string]string{"Free": "$0/month"})
+
+	values := []string{"Free - $0/month", "Pro"}
+
+	assert.True(t, texts.matchLabels(values, []string{"Free", "Pro"}, true))
+	assert.True(t, texts.matchLabels(values, []string{"Pro", "Free"}, false))
+	assert.False(t, texts.matchLabels(values, []string{"Pro", "Free"}, true))
+	assert.False(t, texts.matchLabels(values, []string{"Free"}, false))
+
+	var unknown *optionTexts
+
+	assert.False(t, unknown.matchLabels(values, []string{"Free", "Pro"}, true))
+	assert.True(t, unknown.matchLabels(values, []string{"Free - $0/month", "Pro"}, true))
+	assert.False(t, unknown.isLabel("Go - stable", "Go"))
+}
+
+func TestParseInputLine(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario string
+		screen   Screen
+		expected inputLine
+	}{
+		{
+			scenario: "with hint and default",
+			screen: Screen{
+				lines:   []string{"? Name: [? for help] (johnny) john doe"},
+				width:   80,
+				cursorX: 34,
+			},
+			expected: inputLine{text: "john doe", cursor: 4},
+		},
+		{
+			scenario: "wrapped",
+			screen: Screen{
+				lines:   []string{"? Name: abcdefgh", "ij"},
+				width:   16,
+				cursorX: 2,
+				cursorY: 1,
+			},
+			expected: inputLine{text: "abcdefghij", cursor: 10},
+		},
+		{
+			scenario: "suggestions",
+			screen: Screen{
+				lines:   []string{"? Name: john.doe [Use arrows to move, enter to select, type to continue]", "> john.doe"},
+				width:   80,
+				cursorX: 16,
+			},
+			expected: inputLine{text: "john.doe", cursor: 8},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, parseInputLine(tc.screen, "Name:"))
+		})
+	}
+}
+
+func staticText(text string) func() string {
+	return func() string {
+		return text
+	}
+}
@@ -0,0 +1,378 @@
+package surveyexpect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
+)
+
+var _ expectedStep = (*Form)(nil)
+
+// Form is an expectation of survey.Ask, the prompts are expected by the names of the questions, in the order of the
+// questions. Once the survey is done, the answers that are written to the destination are checked.
+type Form struct {
+	*groupedSteps
+
+	parent    *Survey
+	questions []*survey.Question
+	dst       interface{}
+
+	// positions are the positions of the questions of the prompts.
+	positions map[Step]int
+
+	// answers are the values that are expected to be written to the destination.
+	answers []formAnswer
+}
+
+// formAnswer is a value that is expected to be written to the destination for a question.
+type formAnswer struct {
+	name  string
+	value interface{}
+}
+
+// Input expects the question to be a survey.Input.
+//
+//	Survey.ExpectForm(questions, &answers).
+//		Input("name").
+//		Answer("John Doe")
+func (f *Form) Input(name string) *InputPrompt {
+	i, q := f.question(name, &survey.Input{})
+
+	p := newInput(f.parent, Exact(q.(*survey.Input).Message)).Once()
+	p.question = name
+
+	f.add(i, p)
+
+	return p
+}
+
+// Password expects the question to be a survey.Password.
+//
+//	Survey.ExpectForm(questions, &answers).
+//		Password("password").
+//		Answer("secret")
+func (f *Form) Password(name string) *PasswordPrompt {
+	i, q := f.question(name, &survey.Password{})
+
+	p := newPassword(f.parent, Exact(q.(*survey.Password).Message)).Once()
+	p.question = name
+
+	f.add(i, p)
+
+	return p
+}
+
+// Confirm expects the question to be a survey.Confirm.
+//
+//	Survey.ExpectForm(questions, &answers).
+//		Confirm("subscribe").
+//		Yes()
+func (f *Form) Confirm(name string) *ConfirmPrompt {
+	i, q := f.question(name, &survey.Confirm{})
+
+	p := newConfirm(f.parent, Exact(q.(*survey.Confirm).Message)).Once()
+	p.question = name
+
+	f.add(i, p)
+
+	return p
+}
+
+// Multiline expects the question to be a survey.Multiline.
+//
+//	Survey.ExpectForm(questions, &answers).
+//		Multiline("bio").
+//		Answer("hello\nworld")
+func (f *Form) Multiline(name string) *MultilinePrompt {
+	i, q := f.question(name, &survey.Multiline{})
+
+	p := newMultiline(f.parent, Exact(q.(*survey.Multiline).Message)).Once()
+	p.question = name
+
+	f.add(i, p)
+
+	return p
+}
+
+// Editor expects the question to be a survey.Editor.
+//
+//	Survey.ExpectForm(questions, &answers).
+//		Editor("message").
+//		Answer("Fix typo")
+func (f *Form) Editor(name string) *EditorPrompt {
+	i, q := f.question(name, &survey.Editor{})
+
+	p := newEditor(f.parent, Exact(q.(*survey.Editor).Message)).Once()
+	p.question = name
+
+	f.add(i, p)
+
+	return p
+}
+
+// Select expects the question to be a survey.Select.
+//
+//	Survey.ExpectForm(questions, &answers).
+//		Select("color").
+//		Choose("red")
+func (f *Form) Select(name string) *SelectPrompt {
+	i, q := f.question(name, &survey.Select{})
+
+	p := newSelect(f.parent, Exact(q.(*survey.Select).Message)).Once()
+	p.question = name
+
+	f.add(i, p)
+
+	return p
+}
+
+// MultiSelect expects the question to be a survey.MultiSelect.
+//
+//	Survey.ExpectForm(questions, &answers).
+//		MultiSelect("colors").
+//		Check("red", "blue").
+//		Enter()
+func (f *Form) MultiSelect(name string) *MultiSelectPrompt {
+	i, q := f.question(name, &survey.MultiSelect{})
+
+	p := newMultiSelect(f.parent, Exact(q.(*survey.MultiSelect).Message)).Once()
+	p.question = name
+
+	f.add(i, p)
+
+	return p
+}
+
+// ExpectAnswer expects the value that survey writes to the destination for the question, once the survey is done. The
+// field of a struct is found by its `survey` tag, or by its name regardless of the case. An option that is chosen is
+// compared by its value, so it is a string, or a slice of strings for a survey.MultiSelect.
+//
+//	Survey.ExpectForm(questions, &answers).
+//		ExpectAnswer("name", "John Doe").
+//		ExpectAnswer("subscribe", true).
+//		ExpectAnswer("colors", []string{"red", "blue"})
+func (f *Form) ExpectAnswer(name string, value interface{}) *Form {
+	if f.position(name) < 0 {
+		return f
+	}
+
+	f.parent.mu.Lock()
+	defer f.parent.mu.Unlock()
+
+	f.answers = append(f.answers, formAnswer{name: name, value: value})
+
+	return f
+}
+
+// position finds the position of the question by its name. If there is no such question, the test fails and the
+// position is -1.
+func (f *Form) position(name string) int {
+	for i, q := range f.questions {
+		if q.Name == name {
+			return i
+		}
+	}
+
+	f.parent.test.Errorf("%s: question %q is not in the form", ErrInvalidQuestion.Error(), name)
+
+	return -1
+}
+
+// question finds the question by its name and checks that its prompt has the same type as the given one. If there is
+// no such question or its prompt has another type, the test fails, the position is -1 and the given prompt is returned.
+func (f *Form) question(name string, prompt survey.Prompt) (int, survey.Prompt) {
+	i := f.position(name)
+	if i < 0 {
+		return i, prompt
+	}
+
+	if q := f.questions[i].Prompt; reflect.TypeOf(q) != reflect.TypeOf(prompt) {
+		f.parent.test.Errorf("%s: question %q is a %T, not a %T", ErrInvalidQuestion.Error(), name, q, prompt)
+
+		return -1, prompt
+	}
+
+	return i, f.questions[i].Prompt
+}
+
+// add adds the prompt of the question at the given position, after the prompts of the questions before it, because
+// survey asks the questions in order. The prompt is not added when the position is -1.
+func (f *Form) add(position int, p Step) {
+	if position < 0 {
+		return
+	}
+
+	f.lock()
+	defer f.unlock()
+
+	mustNotClosed(f.closed)
+
+	i := len(f.steps)
+
+	for i > 0 && f.positions[f.steps[i-1]] > position {
+		i--
+	}
+
+	f.steps = append(f.steps, nil)
+	copy(f.steps[i+1:], f.steps[i:])
+	f.steps[i] = p
+
+	f.positions[p] = position
+}
+
+// Do runs the prompt of the next question, the form is not finished until all the prompts are done. The error tells
+// which question fails.
+func (f *Form) Do(c Console) error {
+	step, err := f.do(c)
+	if err != nil && !errors.Is(err, ErrNotFinished) && !IsInterrupted(err) {
+		if question := questionOf(step); question != "" {
+			return fmt.Errorf("question %q: %w", question, err)
+		}
+	}
+
+	return err
+}
+
+// checkAnswers checks the values that survey writes to the destination.
+func (f *Form) checkAnswers() []error {
+	f.parent.mu.Lock()
+	answers := make([]formAnswer, len(f.answers))
+	copy(answers, f.answers)
+	f.parent.mu.Unlock()
+
+	var errs []error
+
+	for _, a := range answers {
+		actual, ok := formValue(f.dst, a.name)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: question %q is not written to %T", ErrUnexpectedFormAnswer, a.name, f.dst))
+
+			continue
+		}
+
+		if !reflect.DeepEqual(a.value, actual) {
+			errs = append(errs, fmt.Errorf("%w: question %q: expected %#v, got %#v",
+				ErrUnexpectedFormAnswer, a.name, a.value, actual,
+			))
+		}
+	}
+
+	return errs
+}
+
+// questionOf returns the name of the question of a prompt in a form.
+func questionOf(step Step) string {
+	if p, ok := step.(interface{ questionName() string }); ok {
+		return p.questionName()
+	}
+
+	return ""
+}
+
+// formValue reads the value of a question from the destination, a pointer to a struct or a map. The options that are
+// chosen are read as their values.
+func formValue(dst interface{}, name string) (interface{}, bool) {
+	v := reflect.ValueOf(dst)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, false
+		}
+
+		v = v.Elem()
+	}
+
+	var field reflect.Value
+
+	switch v.Kind() { //nolint: exhaustive
+	case reflect.Map:
+		field = v.MapIndex(reflect.ValueOf(name))
+
+	case reflect.Struct:
+		field = findFormField(v, name)
+	}
+
+	if !field.IsValid() || !field.CanInterface() {
+		return nil, false
+	}
+
+	return optionValues(field.Interface()), true
+}
+
+// findFormField finds the field of the question the way survey does, by the `survey` tag first, then by the name
+// regardless of the case. The fields of the embedded structs are promoted.
+func findFormField(v reflect.Value, name string) reflect.Value {
+	fields := formFields(v)
+
+	for _, f := range fields {
+		if tag := f.tag.Get("survey"); tag != "" && tag == name {
+			return f.value
+		}
+	}
+
+	for _, f := range fields {
+		if strings.EqualFold(f.name, name) {
+			return f.value
+		}
+	}
+
+	return reflect.Value{}
+}
+
+// formField is a field of a struct that survey writes to.
+type formField struct {
+	name  string
+	tag   reflect.StructTag
+	value reflect.Value
+}
+
+func formFields(v reflect.Value) []formField {
+	t := v.Type()
+	fields := make([]formField, 0, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			fields = append(fields, formFields(v.Field(i))...)
+
+			continue
+		}
+
+		fields = append(fields, formField{name: f.Name, tag: f.Tag, value: v.Field(i)})
+	}
+
+	return fields
+}
+
+// optionValues converts the options that are chosen to their values.
+func optionValues(v interface{}) interface{} {
+	switch o := v.(type) {
+	case core.OptionAnswer:
+		return o.Value
+
+	case []core.OptionAnswer:
+		values := make([]string, len(o))
+
+		for i, a := range o {
+			values[i] = a.Value
+		}
+
+		return values
+	}
+
+	return v
+}
+
+func newForm(parent *Survey, questions []*survey.Question, dst interface{}) *Form {
+	return &Form{
+		groupedSteps: groupSteps(false),
+		parent:       parent,
+		questions:    questions,
+		dst:          dst,
+		positions:    make(map[Step]int),
+	}
+}
@@ -0,0 +1,268 @@
+package surveyexpect_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/stretchr/testify/assert"
+
+	"go.nhat.io/surveyexpect"
+	"go.nhat.io/surveyexpect/options"
+)
+
+type profile struct {
+	Name      string   `survey:"name"`
+	Color     string   `survey:"color"`
+	Topics    []string `survey:"topics"`
+	Subscribe bool
+}
+
+func profileQuestions() []*survey.Question {
+	return []*survey.Question{
+		{
+			Name:      "name",
+			Prompt:    &survey.Input{Message: "What is your name?"},
+			Transform: survey.Title,
+		},
+		{
+			Name:   "color",
+			Prompt: &survey.Select{Message: "Choose a color:", Options: []string{"red", "blue", "green"}},
+		},
+		{
+			Name:   "topics",
+			Prompt: &survey.MultiSelect{Message: "Choose the topics:", Options: []string{"go", "rust", "zig"}},
+		},
+		{
+			Name:   "subscribe",
+			Prompt: &survey.Confirm{Message: "Subscribe to the newsletter?"},
+		},
+	}
+}
+
+func expectProfile(f *surveyexpect.Form) *surveyexpect.Form {
+	f.Input("name").
+		Answer("john doe")
+
+	f.Select("color").
+		Choose("blue")
+
+	f.MultiSelect("topics").
+		Check("go", "zig").
+		Enter()
+
+	f.Confirm("subscribe").
+		Yes()
+
+	return f
+}
+
+func TestForm_Struct(t *testing.T) {
+	t.Parallel()
+
+	var answers profile
+
+	questions := profileQuestions()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		expectProfile(s.ExpectForm(questions, &answers)).
+			ExpectAnswer("name", "John Doe").
+			ExpectAnswer("color", "blue").
+			ExpectAnswer("topics", []string{"go", "zig"}).
+			ExpectAnswer("subscribe", true)
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		err := survey.Ask(questions, &answers, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestForm_Map(t *testing.T) {
+	t.Parallel()
+
+	answers := make(map[string]interface{})
+	questions := profileQuestions()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		expectProfile(s.ExpectForm(questions, &answers)).
+			ExpectAnswer("name", "John Doe").
+			ExpectAnswer("color", "blue").
+			ExpectAnswer("topics", []string{"go", "zig"}).
+			ExpectAnswer("subscribe", true)
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		err := survey.Ask(questions, &answers, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestForm_UnexpectedAnswer(t *testing.T) {
+	t.Parallel()
+
+	var answers profile
+
+	questions := profileQuestions()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		expectProfile(s.ExpectForm(questions, &answers)).
+			ExpectAnswer("name", "john doe").
+			ExpectAnswer("subscribe", false)
+	})(testingT)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		_ = survey.Ask(questions, &answers, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.Contains(t, testingT.ErrorString(), `unexpected form answer: question "name": expected "john doe", got "John Doe"`)
+	assert.Contains(t, testingT.ErrorString(), `unexpected form answer: question "subscribe": expected false, got true`)
+}
+
+func TestForm_NotWritten(t *testing.T) {
+	t.Parallel()
+
+	answers := make(map[string]interface{})
+	questions := profileQuestions()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		f := s.ExpectForm(questions, &answers).
+			ExpectAnswer("name", "John Doe").
+			ExpectAnswer("color", "blue")
+
+		f.Input("name").
+			Answer("john doe")
+	})(testingT)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		_ = survey.Ask(questions[:1], &answers, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	expected := `unexpected form answer: question "color" is not written to *map[string]interface {}`
+
+	assert.Equal(t, expected, testingT.ErrorString())
+}
+
+func TestForm_QuestionFails(t *testing.T) {
+	t.Parallel()
+
+	var answers profile
+
+	questions := profileQuestions()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		f := s.ExpectForm(questions, &answers)
+
+		f.Input("name").
+			ExpectAnswered("john doe").
+			Answer("john doe")
+	})(testingT)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		_ = survey.Ask(questions[:1], &answers, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	expected := `question "name": unexpected rendered answer: expected "john doe", got "John Doe"`
+
+	assert.Equal(t, expected, testingT.ErrorString())
+}
+
+func TestForm_QuestionsInOrder(t *testing.T) {
+	t.Parallel()
+
+	answers := make(map[string]interface{})
+	questions := []*survey.Question{
+		{Name: "first", Prompt: &survey.Input{Message: "Enter a value:"}},
+		{Name: "second", Prompt: &survey.Input{Message: "Enter a value:"}},
+	}
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		f := s.ExpectForm(questions, &answers).
+			ExpectAnswer("first", "alpha").
+			ExpectAnswer("second", "beta")
+
+		// The prompts are expected in the order of the questions.
+		f.Input("second").
+			Answer("beta")
+
+		f.Input("first").
+			Answer("alpha")
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		err := survey.Ask(questions, &answers, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestForm_QuestionsOutOfOrder(t *testing.T) {
+	t.Parallel()
+
+	var answers profile
+
+	questions := profileQuestions()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		f := s.ExpectForm(questions, &answers)
+
+		f.Input("name").
+			Answer("john doe")
+
+		f.Confirm("subscribe").
+			Yes()
+	})(testingT)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		reordered := []*survey.Question{questions[3], questions[0]}
+
+		_ = survey.Ask(reordered, &answers, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.Contains(t, s.ExpectationsWereMet().Error(), `Message: "What is your name?"`)
+}
+
+func TestForm_InvalidQuestion(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	f := surveyexpect.Expect(func(s *surveyexpect.Survey) {})(testingT).
+		ExpectForm(profileQuestions(), nil)
+
+	f.Input("email").
+		Answer("john@example.com")
+
+	f.Input("color").
+		Answer("blue")
+
+	f.ExpectAnswer("email", "john@example.com")
+
+	expected := `invalid question: question "email" is not in the form` +
+		`invalid question: question "color" is a *survey.Select, not a *survey.Input` +
+		`invalid question: question "email" is not in the form`
+
+	assert.Equal(t, expected, testingT.ErrorString())
+	assert.Empty(t, f.String())
+}
@@ -2,32 +2,52 @@ package surveyexpect
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 )
 
 var (
 	_ Prompt = (*InputPrompt)(nil)
 	_ Answer = (*InputAnswer)(nil)
+
+	inputDefaultRegex = regexp.MustCompile(`\((.*)\) $`)
 )
 
 // InputPrompt is an expectation of survey.Input.
 type InputPrompt struct {
 	*basePrompt
 
-	message string
-	answer  Step
+	defaultValue *string
+	answer       Step
 }
 
-// ShowHelp sets help for the expectation.
+// ExpectDefault expects the default value to be shown next to the message. An empty value expects no default.
 //
 //	Survey.ExpectInput("Enter your name:").
-//		ShowHelp("It's your full name")
-func (p *InputPrompt) ShowHelp(help string, options ...string) {
+//		ExpectDefault("johnny").
+//		Answer("john")
+func (p *InputPrompt) ExpectDefault(value string) *InputPrompt {
 	p.lock()
 	defer p.unlock()
 
-	p.answer = helpAnswer(help, options...)
-	p.timesLocked(1)
+	p.defaultValue = &value
+
+	return p
+}
+
+// ShowHelp asks for help and asserts the help text. The prompt is asked again after showing the help, so the next
+// answer is given to the same prompt.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		ShowHelp("It's your full name").
+//		Answer("johnny")
+func (p *InputPrompt) ShowHelp(help string, options ...string) *InputPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.answer = retryAnswer(p.answer, helpAnswer(help, options...), waitForCursorTwice)
+
+	return p
 }
 
 // Interrupt marks the answer is interrupted.
@@ -38,24 +58,81 @@ func (p *InputPrompt) Interrupt() {
 	p.lock()
 	defer p.unlock()
 
-	p.answer = interruptAnswer()
+	p.answer = retryAnswer(p.answer, interruptAnswer(), waitForCursorTwice)
 	p.timesLocked(1)
 }
 
-// Answer sets the answer to the input prompt.
+// Answer sets the answer to the input prompt. If the previous answer expects a validation error, the answer is given
+// when the prompt is asked again.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Answer("johnny")
 //
 //	Survey.ExpectInput("Enter your name:").
+//		Answer("").ExpectValidationError("Value is required").
 //		Answer("johnny")
 func (p *InputPrompt) Answer(answer string) *InputAnswer {
 	p.lock()
 	defer p.unlock()
 
 	a := newInputAnswer(p, answer)
-	p.answer = a
+	p.answer = retryAnswer(p.answer, a, waitForCursorTwice)
+
+	return a
+}
+
+// AnswerFunc computes the answer from the screen when the prompt is asked.
+//
+//	Survey.ExpectInputMatch(surveyexpect.Regexp(`Type '(?P<name>[\w-]+)' to confirm:`)).
+//		AnswerFunc(func(_ surveyexpect.Screen, captures map[string]string) string {
+//			return captures["name"]
+//		})
+func (p *InputPrompt) AnswerFunc(fn AnswerFunc) *InputAnswer {
+	p.lock()
+	defer p.unlock()
+
+	a := newInputAnswerFunc(p, fn)
+	p.answer = retryAnswer(p.answer, a, waitForCursorTwice)
 
 	return a
 }
 
+// Capture captures a value from the screen when the prompt is asked. The first group of the regular expression, or
+// the whole match if there is no group, is stored in dst unless it is nil, and the named groups are passed to
+// AnswerFunc.
+//
+//	Survey.ExpectInput("Enter the code:").
+//		Capture(`Your code is (\d+)`, &code).
+//		Answer("123456")
+func (p *InputPrompt) Capture(pattern string, dst *string) *InputPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.captureLocked(pattern, dst)
+
+	return p
+}
+
+// AnswerSequence gives a different answer each time the prompt is asked, the first answer for the first time, the
+// second one for the second time and so on. The prompt is expected to be asked as many times as the answers.
+//
+//	Survey.ExpectInput("Enter a host name:").
+//		AnswerSequence("alpha", "beta", "gamma")
+func (p *InputPrompt) AnswerSequence(answers ...string) *InputPrompt {
+	p.lock()
+	defer p.unlock()
+
+	steps := make([]Step, 0, len(answers))
+
+	for _, answer := range answers {
+		steps = append(steps, newInputAnswer(p, answer))
+	}
+
+	p.answer = retryAnswer(p.answer, sequenceAnswers(p.basePrompt, steps...), waitForCursorTwice)
+
+	return p
+}
+
 // Type starts a sequence of steps to interact with suggestion mode.
 //
 //	Survey.ExpectInput("Enter your name:").
@@ -65,7 +142,7 @@ func (p *InputPrompt) Type(s string) *InputSuggestionSteps {
 	defer p.unlock()
 
 	a := newInputSuggestionSteps(p, typeAnswer(s))
-	p.answer = a
+	p.answer = retryAnswer(p.answer, a, waitForCursorTwice)
 
 	return a
 }
@@ -79,14 +156,56 @@ func (p *InputPrompt) Tab(times ...int) *InputSuggestionSteps {
 	defer p.unlock()
 
 	a := newInputSuggestionSteps(p, repeatStep(pressTab(), times...)...)
-	p.answer = a
+	p.answer = retryAnswer(p.answer, a, waitForCursorTwice)
+
+	return a
+}
+
+// Press starts a sequence of steps by sending the keys, see ParseKeys for the notation.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Press(surveyexpect.MustParseKeys("johnny<BACKSPACE>y<ENTER>")...)
+func (p *InputPrompt) Press(keys ...Key) *InputSuggestionSteps {
+	p.lock()
+	defer p.unlock()
+
+	a := newInputSuggestionSteps(p, pressKeys(keys...))
+	p.answer = retryAnswer(p.answer, a, waitForCursorTwice)
 
 	return a
 }
 
+// ExpectAnswered expects the answer that survey renders next to the message once the prompt is answered, after the
+// transformer of the question is applied.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		ExpectAnswered("JOHN").
+//		Answer("john")
+func (p *InputPrompt) ExpectAnswered(answer string) *InputPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.expectAnsweredLocked(answer)
+
+	return p
+}
+
 // Do runs the step.
 func (p *InputPrompt) Do(c Console) error {
-	if _, err := c.ExpectString(p.message); err != nil {
+	if err := p.expectMessage(c); err != nil {
+		return err
+	}
+
+	if err := p.expectDefault(c); err != nil {
+		return err
+	}
+
+	// The prompt is ready for the answer once it asks for the cursor position.
+	if err := waitForCursorTwice(c); err != nil {
+		return err
+	}
+
+	if err := p.captureScreen(c); err != nil {
 		return err
 	}
 
@@ -95,21 +214,61 @@ func (p *InputPrompt) Do(c Console) error {
 		return err
 	}
 
+	if err == nil && !expectsRetry(p.answer) {
+		if err := p.readAnswer(c, false); err != nil {
+			return err
+		}
+	}
+
 	p.lock()
 	defer p.unlock()
 
-	p.repeatability--
-	p.totalCalls++
+	p.calledLocked()
 
 	return p.isDoneLocked(err)
 }
 
+func (p *InputPrompt) expectDefault(c Console) error {
+	if p.defaultValue == nil {
+		return nil
+	}
+
+	rendered, err := readRenderedPrompt(c)
+	if err != nil {
+		return err
+	}
+
+	var actual string
+
+	if m := inputDefaultRegex.FindStringSubmatch(rendered); m != nil {
+		actual = m[1]
+	}
+
+	if actual != *p.defaultValue {
+		return unexpectedDefault(*p.defaultValue, actual)
+	}
+
+	return nil
+}
+
 // String represents the expectation as a string.
 func (p *InputPrompt) String() string {
 	var sb stringsBuilder
 
 	sb.WriteLabelLinef("Expect", "Input Prompt").
-		WriteLabelLinef("Message", "%q", p.message)
+		WriteLabelLinef("Message", "%s", p.message)
+
+	if p.question != "" {
+		sb.WriteLabelLinef("Question", "%q", p.question)
+	}
+
+	if p.defaultValue != nil {
+		sb.WriteLabelLinef("Default", "%q", *p.defaultValue)
+	}
+
+	if p.answered != nil {
+		sb.WriteLabelLinef("Answered", "%q", *p.answered)
+	}
 
 	if steps, ok := p.answer.(*InputSuggestionSteps); ok {
 		sb.WriteString(steps.String())
@@ -117,8 +276,8 @@ func (p *InputPrompt) String() string {
 		sb.WriteLabelLinef("Answer", p.answer.String())
 	}
 
-	if p.repeatability > 0 && (p.totalCalls != 0 || p.repeatability != 1) {
-		sb.WriteLinef("(called: %d time(s), remaining: %d time(s))", p.totalCalls, p.repeatability)
+	if calls := p.callsString(); calls != "" {
+		sb.WriteLinef("%s", calls)
 	}
 
 	return sb.String()
@@ -153,24 +312,77 @@ func (p *InputPrompt) Times(i int) *InputPrompt {
 	return p
 }
 
+// Maybe indicates that the message may be asked once, or not at all.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Answer("johnny").
+//		Maybe()
+func (p *InputPrompt) Maybe() *InputPrompt {
+	return p.AtMost(1)
+}
+
+// AtLeast indicates that the message should be asked at least the indicated number of times.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Answer("johnny").
+//		AtLeast(2)
+func (p *InputPrompt) AtLeast(i int) *InputPrompt {
+	p.cardinality(i, anyTimes)
+
+	return p
+}
+
+// AtMost indicates that the message may be asked up to the indicated number of times, or not at all.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Answer("johnny").
+//		AtMost(3)
+func (p *InputPrompt) AtMost(i int) *InputPrompt {
+	p.cardinality(0, i)
+
+	return p
+}
+
+// AnyTimes indicates that the message may be asked any number of times, or not at all.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Answer("johnny").
+//		AnyTimes()
+func (p *InputPrompt) AnyTimes() *InputPrompt {
+	p.cardinality(0, anyTimes)
+
+	return p
+}
+
 // InputAnswer is an answer for password question.
 type InputAnswer struct {
-	parent      *InputPrompt
-	answer      string
-	interrupted bool
+	parent          *InputPrompt
+	answer          string
+	fn              AnswerFunc
+	validationError string
+	interrupted     bool
 }
 
 // Do runs the step.
 // nolint: errcheck,gosec,nolintlint
 func (a *InputAnswer) Do(c Console) error {
+	answer, err := answerOf(c, a.parent.parent, a.answer, a.fn)
+	if err != nil {
+		return err
+	}
+
 	if a.interrupted {
-		c.Send(a.answer)
+		c.Send(answer)
 		c.ExpectEOF()
 
 		return nil
 	}
 
-	c.SendLine(a.answer)
+	c.SendLine(answer)
+
+	if a.validationError != "" {
+		return expectValidationError(a.validationError).Do(c)
+	}
 
 	return nil
 }
@@ -181,29 +393,61 @@ func (a *InputAnswer) Interrupted() {
 	defer a.parent.unlock()
 
 	a.interrupted = true
+	a.validationError = ""
+}
+
+// ExpectValidationError expects the answer to be rejected by a validator, so the prompt is asked again.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Answer("").ExpectValidationError("Value is required").
+//		Answer("johnny")
+func (a *InputAnswer) ExpectValidationError(err string) *InputPrompt {
+	a.parent.lock()
+	defer a.parent.unlock()
+
+	a.validationError = err
+	a.interrupted = false
+
+	return a.parent
+}
+
+func (a *InputAnswer) expectsRetry() bool {
+	return a.validationError != ""
 }
 
 // String represents the answer as a string.
 func (a *InputAnswer) String() string {
 	var sb strings.Builder
 
-	_, _ = fmt.Fprintf(&sb, "%q", a.answer)
+	if a.fn != nil {
+		_, _ = sb.WriteString(computedAnswer)
+	} else {
+		_, _ = fmt.Fprintf(&sb, "%q", a.answer)
+	}
 
 	if a.interrupted {
 		_, _ = sb.WriteString(" and get interrupted")
+	} else if a.validationError != "" {
+		_, _ = fmt.Fprintf(&sb, " and get validation error %q", a.validationError)
 	}
 
 	return sb.String()
 }
 
-func newInput(parent *Survey, message string) *InputPrompt {
+func newInput(parent *Survey, message Matcher) *InputPrompt {
 	return &InputPrompt{
-		basePrompt: &basePrompt{parent: parent},
-		message:    message,
+		basePrompt: &basePrompt{parent: parent, message: message},
 		answer:     noAnswer(),
 	}
 }
 
+func newInputAnswerFunc(parent *InputPrompt, fn AnswerFunc) *InputAnswer {
+	return &InputAnswer{
+		parent: parent,
+		fn:     fn,
+	}
+}
+
 func newInputAnswer(parent *InputPrompt, answer string) *InputAnswer {
 	return &InputAnswer{
 		parent: parent,
@@ -233,7 +477,7 @@ func (a *InputSuggestionSteps) append(steps ...Step) *InputSuggestionSteps {
 //		Tab(5)
 func (a *InputSuggestionSteps) Tab(times ...int) *InputSuggestionSteps {
 	return a.append(repeatStep(pressTab(), times...)...).
-		append(expectString(a.parent.message), expectString(`[Use arrows to move, enter to select, type to continue]`))
+		append(expectMessageAgain(a.parent.message, a.parent.text), expectString(`[Use arrows to move, enter to select, type to continue]`))
 }
 
 // Esc sends the ESC key.
@@ -242,7 +486,7 @@ func (a *InputSuggestionSteps) Tab(times ...int) *InputSuggestionSteps {
 //		Type("hello").
 //		Esc()
 func (a *InputSuggestionSteps) Esc() *InputSuggestionSteps {
-	return a.append(pressEsc(), expectString(a.parent.message), expectString(`for suggestions]`))
+	return a.append(pressEsc(), expectMessageAgain(a.parent.message, a.parent.text), expectString(`for suggestions]`))
 }
 
 // Enter sends the ENTER key and ends the sequence.
@@ -255,6 +499,18 @@ func (a *InputSuggestionSteps) Enter() {
 	a.steps.Close()
 }
 
+// Accept moves the cursor to the suggestion, confirms that the input shows it and sends the ENTER key. The list is
+// scrolled when the suggestion is not visible. It ends the sequence.
+//
+//	Survey.ExpectInput("Enter a file name:").
+//		Type("ma").
+//		Tab().
+//		Accept("main.go")
+func (a *InputSuggestionSteps) Accept(suggestion string) {
+	a.append(acceptSuggestion(a.parent.text, suggestion))
+	a.steps.Close()
+}
+
 // Interrupt sends ^C and closes the suggestions.
 //
 //	Survey.ExpectInput("Enter your name:").
@@ -304,11 +560,108 @@ func (a *InputSuggestionSteps) Type(s string) *InputSuggestionSteps {
 	return a.append(typeAnswer(s))
 }
 
+// Press sends the keys, see ParseKeys for the notation.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Type("johnny").
+//		Press(surveyexpect.KeyCtrlA, surveyexpect.KeyCtrlK)
+func (a *InputSuggestionSteps) Press(keys ...Key) *InputSuggestionSteps {
+	return a.append(pressKeys(keys...))
+}
+
+// MoveLeft sends the ARROW LEFT key the indicated times to move the cursor in the text. Default is 1 when omitted.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Type("johny").
+//		MoveLeft().
+//		Type("n")
+func (a *InputSuggestionSteps) MoveLeft(times ...int) *InputSuggestionSteps {
+	return a.append(repeatStep(pressArrowLeft(), times...)...)
+}
+
+// MoveRight sends the ARROW RIGHT key the indicated times to move the cursor in the text. Default is 1 when omitted.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Type("johnny").
+//		Home().
+//		MoveRight(4)
+func (a *InputSuggestionSteps) MoveRight(times ...int) *InputSuggestionSteps {
+	return a.append(repeatStep(pressArrowRight(), times...)...)
+}
+
+// Home sends the HOME key to move the cursor to the beginning of the text.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Type("johnny").
+//		Home()
+func (a *InputSuggestionSteps) Home() *InputSuggestionSteps {
+	return a.append(pressHome())
+}
+
+// End sends the END key to move the cursor to the end of the text.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Type("johnny").
+//		Home().
+//		End()
+func (a *InputSuggestionSteps) End() *InputSuggestionSteps {
+	return a.append(pressEnd())
+}
+
+// Backspace sends the BACKSPACE key the indicated times to delete the characters before the cursor. Default is 1 when
+// omitted.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Type("johnny").
+//		MoveLeft(2).
+//		Backspace()
+func (a *InputSuggestionSteps) Backspace(times ...int) *InputSuggestionSteps {
+	return a.append(repeatStep(pressBackspace(), times...)...)
+}
+
+// ExpectBuffer expects the text that is being edited, as it is shown on the screen. The spaces at the end of the text
+// are not visible.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Type("johny").
+//		MoveLeft().
+//		Type("n").
+//		ExpectBuffer("johnny")
+func (a *InputSuggestionSteps) ExpectBuffer(text string) *InputSuggestionSteps {
+	return a.append(expectBuffer(a.parent.text, text))
+}
+
+// ExpectCursor expects the position of the cursor in the text, starting from 0 at the beginning of the text.
+//
+//	Survey.ExpectInput("Enter your name:").
+//		Type("johnny").
+//		MoveLeft(2).
+//		ExpectCursor(4)
+func (a *InputSuggestionSteps) ExpectCursor(position int) *InputSuggestionSteps {
+	return a.append(expectCursor(a.parent.text, position))
+}
+
 // ExpectSuggestions expects a list of suggestions.
 func (a *InputSuggestionSteps) ExpectSuggestions(suggestions ...string) *InputSuggestionSteps {
 	return a.append(expectSelect(suggestions...))
 }
 
+// ExpectExactOptions expects exactly the list of visible suggestions, in order. The highlighted suggestion is prefixed
+// with "> ".
+func (a *InputSuggestionSteps) ExpectExactOptions(suggestions ...string) *InputSuggestionSteps {
+	return a.append(expectExactOptions(false, suggestions...))
+}
+
+// ExpectHighlighted expects the suggestion to be highlighted.
+func (a *InputSuggestionSteps) ExpectHighlighted(suggestion string) *InputSuggestionSteps {
+	return a.append(expectHighlightedOption(false, nil, suggestion))
+}
+
+// ExpectFilter expects the text that is shown next to the message while the suggestions are listed.
+func (a *InputSuggestionSteps) ExpectFilter(filter string) *InputSuggestionSteps {
+	return a.append(expectFilter(a.parent.text, filter))
+}
+
 // Do runs the step.
 func (a *InputSuggestionSteps) Do(c Console) error {
 	return a.steps.Do(c)
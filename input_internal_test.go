@@ -9,7 +9,7 @@ import (
 func TestInputPrompt_Once(t *testing.T) {
 	t.Parallel()
 
-	p := newInput(&Survey{}, "").Once()
+	p := newInput(&Survey{}, Exact("")).Once()
 
 	assert.Equal(t, 1, p.repeatability)
 }
@@ -17,7 +17,7 @@ func TestInputPrompt_Once(t *testing.T) {
 func TestInputPrompt_Twice(t *testing.T) {
 	t.Parallel()
 
-	p := newInput(&Survey{}, "").Twice()
+	p := newInput(&Survey{}, Exact("")).Twice()
 
 	assert.Equal(t, 2, p.repeatability)
 }
@@ -25,17 +25,76 @@ func TestInputPrompt_Twice(t *testing.T) {
 func TestInputPrompt_Times(t *testing.T) {
 	t.Parallel()
 
-	p := newInput(&Survey{}, "").Times(5)
+	p := newInput(&Survey{}, Exact("")).Times(5)
 
 	assert.Equal(t, 5, p.repeatability)
 }
 
+func TestInputPrompt_ShowHelpKeepsTimes(t *testing.T) {
+	t.Parallel()
+
+	p := newInput(&Survey{}, Exact("")).Times(2).ShowHelp("It's your full name")
+
+	assert.Equal(t, 2, p.repeatability)
+}
+
+func TestInputPrompt_Cardinality(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario              string
+		prompt                *InputPrompt
+		expectedRepeatability int
+		expectedOptional      int
+	}{
+		{
+			scenario:         "maybe",
+			prompt:           newInput(&Survey{}, Exact("")).Maybe(),
+			expectedOptional: 1,
+		},
+		{
+			scenario:              "at least",
+			prompt:                newInput(&Survey{}, Exact("")).AtLeast(2),
+			expectedRepeatability: 2,
+			expectedOptional:      anyTimes,
+		},
+		{
+			scenario:         "at most",
+			prompt:           newInput(&Survey{}, Exact("")).AtMost(3),
+			expectedOptional: 3,
+		},
+		{
+			scenario:         "any times",
+			prompt:           newInput(&Survey{}, Exact("")).AnyTimes(),
+			expectedOptional: anyTimes,
+		},
+		{
+			scenario:              "times",
+			prompt:                newInput(&Survey{}, Exact("")).AnyTimes().Times(2),
+			expectedRepeatability: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expectedRepeatability, tc.prompt.repeatability)
+			assert.Equal(t, tc.expectedOptional, tc.prompt.optional)
+			assert.Equal(t, tc.expectedOptional != 0 && tc.expectedRepeatability == 0, tc.prompt.isOptional())
+		})
+	}
+}
+
 func TestInputPrompt_String(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		scenario      string
+		defaultValue  *string
 		repeatability int
+		optional      int
 		totalCalls    int
 		expected      string
 	}{
@@ -54,6 +113,24 @@ func TestInputPrompt_String(t *testing.T) {
 			totalCalls:    1,
 			expected:      "Expect : Input Prompt\nMessage: \"Enter the username:\"\nAnswer : <no answer>\n(called: 1 time(s), remaining: 3 time(s))\n",
 		},
+		{
+			scenario:      "at least",
+			repeatability: 1,
+			optional:      anyTimes,
+			expected:      "Expect : Input Prompt\nMessage: \"Enter the username:\"\nAnswer : <no answer>\n(called: 0 time(s), remaining: at least 1 time(s))\n",
+		},
+		{
+			scenario:      "between",
+			repeatability: 1,
+			optional:      2,
+			totalCalls:    1,
+			expected:      "Expect : Input Prompt\nMessage: \"Enter the username:\"\nAnswer : <no answer>\n(called: 1 time(s), remaining: 1 to 3 time(s))\n",
+		},
+		{
+			scenario:     "with default",
+			defaultValue: stringPtr("johnny"),
+			expected:     "Expect : Input Prompt\nMessage: \"Enter the username:\"\nDefault: \"johnny\"\nAnswer : <no answer>\n",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -64,10 +141,12 @@ func TestInputPrompt_String(t *testing.T) {
 			p := &InputPrompt{
 				basePrompt: &basePrompt{
 					repeatability: tc.repeatability,
+					optional:      tc.optional,
 					totalCalls:    tc.totalCalls,
+					message:       Exact("Enter the username:"),
 				},
-				message: "Enter the username:",
-				answer:  noAnswer(),
+				defaultValue: tc.defaultValue,
+				answer:       noAnswer(),
 			}
 
 			assert.Equal(t, tc.expected, p.String())
@@ -79,9 +158,10 @@ func TestInputAnswer_String(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		scenario    string
-		interrupted bool
-		expected    string
+		scenario        string
+		interrupted     bool
+		validationError string
+		expected        string
 	}{
 		{
 			scenario: "not interrupted",
@@ -92,6 +172,11 @@ func TestInputAnswer_String(t *testing.T) {
 			interrupted: true,
 			expected:    `"username" and get interrupted`,
 		},
+		{
+			scenario:        "validation error",
+			validationError: "Value is required",
+			expected:        `"username" and get validation error "Value is required"`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -100,11 +185,56 @@ func TestInputAnswer_String(t *testing.T) {
 			t.Parallel()
 
 			a := &InputAnswer{
-				answer:      "username",
-				interrupted: tc.interrupted,
+				answer:          "username",
+				interrupted:     tc.interrupted,
+				validationError: tc.validationError,
 			}
 
 			assert.Equal(t, tc.expected, a.String())
 		})
 	}
 }
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestInputPrompt_StringWithAnswerFunc(t *testing.T) {
+	t.Parallel()
+
+	p := newInput(&Survey{}, Regexp(`Type '(?P<name>\w+)' to confirm:`))
+
+	p.AnswerFunc(func(Screen, map[string]string) string {
+		return ""
+	})
+
+	expected := "Expect : Input Prompt\nMessage: regexp \"Type '(?P<name>\\\\w+)' to confirm:\"\nAnswer : <computed>\n"
+
+	assert.Equal(t, expected, p.String())
+}
+
+func TestInputPrompt_StringWithQuestion(t *testing.T) {
+	t.Parallel()
+
+	p := newInput(&Survey{}, Exact("Enter your name:"))
+	p.question = "name"
+
+	p.Answer("john")
+
+	expected := "Expect : Input Prompt\nMessage: \"Enter your name:\"\nQuestion: \"name\"\nAnswer : \"john\"\n"
+
+	assert.Equal(t, expected, p.String())
+}
+
+func TestInputPrompt_StringWithAnswered(t *testing.T) {
+	t.Parallel()
+
+	p := newInput(&Survey{}, Exact("Enter your name:"))
+
+	p.ExpectAnswered("JOHN").
+		Answer("john")
+
+	expected := "Expect : Input Prompt\nMessage: \"Enter your name:\"\nAnswered: \"JOHN\"\nAnswer : \"john\"\n"
+
+	assert.Equal(t, expected, p.String())
+}
@@ -1,6 +1,8 @@
 package surveyexpect_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +21,7 @@ func TestInputPrompt(t *testing.T) {
 		scenario       string
 		expectSurvey   surveyexpect.Expector
 		message        string
+		defaultValue   string
 		help           string
 		showHelp       bool
 		options        []survey.AskOpt
@@ -49,6 +52,46 @@ func TestInputPrompt(t *testing.T) {
 			message:        "Enter a username:",
 			expectedAnswer: "secret",
 		},
+		{
+			scenario: "press keys",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a username:").
+					Press(surveyexpect.MustParseKeys("secrt<LEFT>e<ENTER>")...)
+			}),
+			message:        "Enter a username:",
+			expectedAnswer: "secret",
+		},
+		{
+			scenario: "no answer uses the default",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a username:").
+					ExpectDefault("johnny")
+			}),
+			message:        "Enter a username:",
+			defaultValue:   "johnny",
+			expectedAnswer: "johnny",
+		},
+		{
+			scenario: "answer overrides the default",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a username:").
+					ExpectDefault("johnny").
+					Answer("secret")
+			}),
+			message:        "Enter a username:",
+			defaultValue:   "johnny",
+			expectedAnswer: "secret",
+		},
+		{
+			scenario: "answer without default",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a username:").
+					ExpectDefault("").
+					Answer("secret")
+			}),
+			message:        "Enter a username:",
+			expectedAnswer: "secret",
+		},
 		{
 			scenario: "answer with visible help and do not ask for it",
 			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
@@ -97,6 +140,17 @@ func TestInputPrompt(t *testing.T) {
 			help:           "It is your email",
 			expectedAnswer: "secret",
 		},
+		{
+			scenario: "ask for help and then answer",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a username:").
+					ShowHelp("It is your email").
+					Answer("secret")
+			}),
+			message:        "Enter a username:",
+			help:           "It is your email",
+			expectedAnswer: "secret",
+		},
 		{
 			scenario: "input is interrupted",
 			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
@@ -134,6 +188,34 @@ func TestInputPrompt(t *testing.T) {
 			message:        "Enter a username:",
 			expectedAnswer: "secret",
 		},
+		{
+			scenario: "answer is rejected by validators",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a username:").
+					Answer("").ExpectValidationError("Value is required").
+					Answer("me").ExpectValidationError("value is too short. Min length is 3").
+					Answer("secret")
+			}),
+			options: []survey.AskOpt{
+				survey.WithValidator(survey.Required),
+				survey.WithValidator(survey.MinLength(3)),
+			},
+			message:        "Enter a username:",
+			expectedAnswer: "secret",
+		},
+		{
+			scenario: "answer is rejected by validators and interrupted",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a username:").
+					Answer("").ExpectValidationError("Value is required").
+					Interrupt()
+			}),
+			options: []survey.AskOpt{
+				survey.WithValidator(survey.Required),
+			},
+			message:       "Enter a username:",
+			expectedError: "interrupt",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -144,7 +226,7 @@ func TestInputPrompt(t *testing.T) {
 			// Prepare the survey.
 			s := tc.expectSurvey(t)
 			p := &survey.InputTemplateData{
-				Input:    survey.Input{Message: tc.message, Help: tc.help},
+				Input:    survey.Input{Message: tc.message, Help: tc.help, Default: tc.defaultValue},
 				ShowHelp: tc.showHelp,
 			}
 
@@ -167,6 +249,214 @@ func TestInputPrompt(t *testing.T) {
 	}
 }
 
+func TestInputPrompt_AnswerSequence(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectInput("Host name:").
+			AnswerSequence("alpha", "beta", "gamma")
+	})(t)
+
+	p := &survey.Input{Message: "Host name:"}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answers []string
+
+		for i := 0; i < 3; i++ {
+			var answer string
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+
+			answers = append(answers, answer)
+		}
+
+		assert.Equal(t, []string{"alpha", "beta", "gamma"}, answers)
+	})
+}
+
+func TestInputPrompt_AnswerSequenceNotFinished(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(100 * time.Millisecond)
+
+		s.ExpectInput("Host name:").
+			AnswerSequence("alpha", "beta", "gamma")
+	})(testingT)
+
+	expectedError := "there are remaining expectations that were not met:\n\nExpect : Input Prompt\nMessage: \"Host name:\"\nAnswer : #3 \"gamma\"\n(called: 2 time(s), remaining: 1 time(s))\n"
+
+	p := &survey.Input{Message: "Host name:"}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		for i := 0; i < 2; i++ {
+			var answer string
+			_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+		}
+	})
+
+	assert.EqualError(t, s.ExpectationsWereMet(), expectedError)
+}
+
+func TestInputPrompt_AnswerFunc(t *testing.T) {
+	t.Parallel()
+
+	var code string
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectInputMatch(surveyexpect.Regexp(`Type '(?P<name>[\w-]+)' to confirm:`)).
+			AnswerFunc(func(_ surveyexpect.Screen, captures map[string]string) string {
+				return captures["name"]
+			})
+
+		s.ExpectInput("Enter the code:").
+			Capture(`Your code is (\d+)`, &code).
+			AnswerFunc(func(screen surveyexpect.Screen, _ map[string]string) string {
+				lines := screen.Lines()
+
+				return strings.TrimPrefix(lines[len(lines)-2], "Your code is ")
+			})
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var name, answer string
+
+		err := survey.AskOne(&survey.Input{Message: "Type 'prod-7f3a' to confirm:"}, &name, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "prod-7f3a", name)
+
+		_, _ = fmt.Fprintln(stdio.Out, "Your code is 123456") //nolint: errcheck
+
+		err = survey.AskOne(&survey.Input{Message: "Enter the code:"}, &answer, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "123456", answer)
+	})
+
+	assert.Equal(t, "123456", code)
+	assert.Equal(t, map[string]string{"name": "prod-7f3a"}, s.Captures())
+}
+
+func TestInputPrompt_NothingCaptured(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		s.ExpectInput("Enter the code:").
+			Capture(`Your code is (\d+)`, nil).
+			Answer("123456")
+	})(testingT)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		_ = survey.AskOne(&survey.Input{Message: "Enter the code:"}, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.Contains(t, testingT.ErrorString(), `nothing is captured: "Your code is (\\d+)" in:`)
+}
+
+func TestInputPrompt_ExpectAnswered(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectInput("Enter your name:").
+			ExpectAnswered("JOHN").
+			Answer("john")
+
+		s.ExpectInput("Enter your city:").
+			ExpectAnswered("Paris").
+			Answer("paris")
+	})(t)
+
+	qs := []*survey.Question{
+		{
+			Name:      "name",
+			Prompt:    &survey.Input{Message: "Enter your name:"},
+			Transform: survey.TransformString(strings.ToUpper),
+		},
+		{
+			Name:      "city",
+			Prompt:    &survey.Input{Message: "Enter your city:"},
+			Transform: survey.Title,
+		},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		answers := struct {
+			Name string
+			City string
+		}{}
+
+		err := survey.Ask(qs, &answers, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "JOHN", answers.Name)
+		assert.Equal(t, "Paris", answers.City)
+	})
+}
+
+func TestInputPrompt_UnexpectedAnswered(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		s.ExpectInput("Enter your name:").
+			ExpectAnswered("john").
+			Answer("john")
+	})(testingT)
+
+	q := []*survey.Question{{
+		Name:      "name",
+		Prompt:    &survey.Input{Message: "Enter your name:"},
+		Transform: survey.TransformString(strings.ToUpper),
+	}}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		_ = survey.Ask(q, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.Equal(t, `unexpected rendered answer: expected "john", got "JOHN"`, testingT.ErrorString())
+}
+
+func TestInputPrompt_UnexpectedDefault(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(100 * time.Millisecond)
+
+		s.ExpectInput("Enter a username:").
+			ExpectDefault("johnny").
+			Answer("secret")
+	})(testingT)
+
+	p := &survey.Input{Message: "Enter a username:", Default: "john (doe)"}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	expectedError := `unexpected default value: expected "johnny", got "john (doe)"`
+
+	assert.Contains(t, testingT.ErrorString(), expectedError)
+}
+
 func TestInputPrompt_NoHelpButStillExpect(t *testing.T) {
 	t.Parallel()
 
@@ -337,6 +627,238 @@ func TestInputPrompt_AskForSuggestions(t *testing.T) {
 	})
 }
 
+func TestInputPrompt_ExpectSuggestionList(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectInput("Enter username:").
+			Type("joh").Tab().
+			ExpectExactOptions(
+				"> john.doe",
+				"john.lennon",
+				"john.legend",
+			).
+			ExpectHighlighted("john.doe").
+			ExpectFilter("john.doe").
+			MoveDown().
+			ExpectHighlighted("john.lennon").
+			ExpectFilter("john.lennon").
+			Enter()
+	})(t)
+
+	p := &survey.Input{
+		Message: "Enter username:",
+		Suggest: func(string) []string {
+			return []string{"john.doe", "john.lennon", "john.legend"}
+		},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.Equal(t, "john.lennon", answer)
+		assert.NoError(t, err)
+	})
+}
+
+func TestInputPrompt_AcceptSuggestion(t *testing.T) {
+	t.Parallel()
+
+	files := make([]string, 0, 20)
+
+	for i := 0; i < 20; i++ {
+		files = append(files, fmt.Sprintf("file%02d.go", i))
+	}
+
+	testCases := []struct {
+		scenario       string
+		expectSurvey   surveyexpect.Expector
+		expectedAnswer string
+	}{
+		{
+			scenario: "visible suggestion",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a file name:").
+					Type("file").Tab().
+					Accept("file03.go")
+			}),
+			expectedAnswer: "file03.go",
+		},
+		{
+			scenario: "suggestion on a next page",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a file name:").
+					Type("file").Tab().
+					Accept("file17.go")
+			}),
+			expectedAnswer: "file17.go",
+		},
+		{
+			scenario: "suggestion above the cursor",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a file name:").
+					Type("file").Tab().
+					MoveDown(4).
+					Accept("file01.go")
+			}),
+			expectedAnswer: "file01.go",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			p := &survey.Input{
+				Message: "Enter a file name:",
+				Suggest: func(string) []string {
+					return files
+				},
+			}
+
+			// Start the survey.
+			tc.expectSurvey(t).Start(func(stdio terminal.Stdio) {
+				var answer string
+				err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedAnswer, answer)
+			})
+		})
+	}
+}
+
+func TestInputPrompt_AcceptSuggestionNotFound(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		s.ExpectInput("Enter username:").
+			Type("joh").Tab().
+			Accept("john.wick")
+	})(testingT)
+
+	p := &survey.Input{
+		Message: "Enter username:",
+		Suggest: func(string) []string {
+			return []string{"john.doe", "john.lennon", "john.legend"}
+		},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	expected := "option not found: \"john.wick\", visible options:\n> john.doe\n  john.lennon\n  john.legend"
+
+	assert.Contains(t, testingT.ErrorString(), expected)
+}
+
+func TestInputPrompt_LineEditing(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectInput("Enter your name:").
+			Type("hello world").
+			ExpectBuffer("hello world").
+			ExpectCursor(11).
+			MoveLeft(5).
+			ExpectCursor(6).
+			Backspace().
+			ExpectBuffer("helloworld").
+			ExpectCursor(5).
+			Type(", ").
+			ExpectBuffer("hello, world").
+			Home().
+			ExpectCursor(0).
+			Type(">").
+			End().
+			ExpectCursor(13).
+			MoveLeft().
+			MoveRight().
+			ExpectBuffer(">hello, world").
+			Enter()
+	})(t)
+
+	p := &survey.Input{
+		Message: "Enter your name:",
+		Default: "johnny",
+		Help:    "It's your full name",
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.Equal(t, ">hello, world", answer)
+		assert.NoError(t, err)
+	})
+}
+
+func TestInputPrompt_UnexpectedLine(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario      string
+		expectSurvey  surveyexpect.Expector
+		expectedError string
+	}{
+		{
+			scenario: "buffer",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectInput("Enter your name:").
+					Type("johny").
+					ExpectBuffer("johnny").
+					Enter()
+			}),
+			expectedError: `unexpected buffer: expected "johnny", got "johny"`,
+		},
+		{
+			scenario: "cursor",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectInput("Enter your name:").
+					Type("johnny").
+					MoveLeft(2).
+					ExpectCursor(2).
+					Enter()
+			}),
+			expectedError: `unexpected cursor position: expected 2, got 4 in "johnny"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			testingT := T()
+			s := tc.expectSurvey(testingT)
+
+			p := &survey.Input{Message: "Enter your name:"}
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				var answer string
+				_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+			})
+
+			assert.Contains(t, testingT.ErrorString(), tc.expectedError)
+		})
+	}
+}
+
 func TestInputPrompt_AskForSuggestionsThenInterrupt(t *testing.T) {
 	t.Parallel()
 
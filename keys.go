@@ -0,0 +1,264 @@
+package surveyexpect
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Key is a key, or a sequence of keys, that is sent to the terminal as it is. A Key can be any text, for example
+// Key("hello") types the word.
+type Key string
+
+// Keys that a terminal sends when they are pressed.
+const (
+	KeyEnter     Key = "\r"
+	KeyTab       Key = "\t"
+	KeyEsc       Key = "\x1b"
+	KeySpace     Key = " "
+	KeyBackspace Key = "\x7f"
+	KeyDelete    Key = "\x1b[3~"
+	KeyUp        Key = "\x1b[A"
+	KeyDown      Key = "\x1b[B"
+	KeyRight     Key = "\x1b[C"
+	KeyLeft      Key = "\x1b[D"
+	KeyHome      Key = "\x1b[H"
+	KeyEnd       Key = "\x1b[F"
+	KeyPageUp    Key = "\x1b[5~"
+	KeyPageDown  Key = "\x1b[6~"
+
+	KeyCtrlA Key = "\x01"
+	KeyCtrlC Key = "\x03"
+	KeyCtrlD Key = "\x04"
+	KeyCtrlE Key = "\x05"
+	KeyCtrlK Key = "\x0b"
+	KeyCtrlU Key = "\x15"
+	KeyCtrlW Key = "\x17"
+
+	KeyF1  Key = "\x1bOP"
+	KeyF2  Key = "\x1bOQ"
+	KeyF3  Key = "\x1bOR"
+	KeyF4  Key = "\x1bOS"
+	KeyF5  Key = "\x1b[15~"
+	KeyF6  Key = "\x1b[17~"
+	KeyF7  Key = "\x1b[18~"
+	KeyF8  Key = "\x1b[19~"
+	KeyF9  Key = "\x1b[20~"
+	KeyF10 Key = "\x1b[21~"
+	KeyF11 Key = "\x1b[23~"
+	KeyF12 Key = "\x1b[24~"
+)
+
+// keyNames are the names of the keys in the notation, in the order of lookup when a key is formatted.
+var keyNames = []struct {
+	name string
+	key  Key
+}{
+	{"ENTER", KeyEnter},
+	{"TAB", KeyTab},
+	{"ESC", KeyEsc},
+	{"SPACE", KeySpace},
+	{"BACKSPACE", KeyBackspace},
+	{"DELETE", KeyDelete},
+	{"UP", KeyUp},
+	{"DOWN", KeyDown},
+	{"RIGHT", KeyRight},
+	{"LEFT", KeyLeft},
+	{"HOME", KeyHome},
+	{"END", KeyEnd},
+	{"PAGEUP", KeyPageUp},
+	{"PAGEDOWN", KeyPageDown},
+	{"F1", KeyF1},
+	{"F2", KeyF2},
+	{"F3", KeyF3},
+	{"F4", KeyF4},
+	{"F5", KeyF5},
+	{"F6", KeyF6},
+	{"F7", KeyF7},
+	{"F8", KeyF8},
+	{"F9", KeyF9},
+	{"F10", KeyF10},
+	{"F11", KeyF11},
+	{"F12", KeyF12},
+	{"LT", "<"},
+	{"ESCAPE", KeyEsc},
+}
+
+// EscapeSequence creates a key that sends ESC followed by the sequence, for example EscapeSequence("[1;5C") is
+// CTRL+RIGHT in xterm.
+func EscapeSequence(seq string) Key {
+	return KeyEsc + Key(seq)
+}
+
+// ParseKeys parses the readable notation of a sequence of keys. Text is typed as it is, and a key is written between
+// angle brackets:
+//
+//   - <ENTER>, <TAB>, <ESC>, <SPACE>, <BACKSPACE>, <DELETE>
+//   - <UP>, <DOWN>, <LEFT>, <RIGHT>, <HOME>, <END>, <PAGEUP>, <PAGEDOWN>
+//   - <F1> to <F12>
+//   - <CTRL+A> to <CTRL+Z>
+//   - <ESC[1;5C> for an arbitrary escape sequence
+//   - <LT> for the "<" character
+//
+// The names are case-insensitive.
+//
+//	keys, err := surveyexpect.ParseKeys("hello<TAB><DOWN><ENTER>")
+func ParseKeys(notation string) ([]Key, error) {
+	var (
+		keys []Key
+		text strings.Builder
+	)
+
+	flush := func() {
+		if text.Len() > 0 {
+			keys = append(keys, Key(text.String()))
+			text.Reset()
+		}
+	}
+
+	for rest := notation; rest != ""; {
+		start := strings.IndexByte(rest, '<')
+		if start < 0 {
+			text.WriteString(rest)
+
+			break
+		}
+
+		text.WriteString(rest[:start])
+
+		end := strings.IndexByte(rest[start:], '>')
+		if end < 0 {
+			return nil, fmt.Errorf("%w: missing \">\" in %q", ErrInvalidKeyNotation, rest[start:])
+		}
+
+		k, err := parseKey(rest[start+1 : start+end])
+		if err != nil {
+			return nil, err
+		}
+
+		// The "<" character is a part of the text.
+		if k == "<" {
+			text.WriteString(string(k))
+		} else {
+			flush()
+
+			keys = append(keys, k)
+		}
+
+		rest = rest[start+end+1:]
+	}
+
+	flush()
+
+	return keys, nil
+}
+
+// MustParseKeys is like ParseKeys but panics if the notation cannot be parsed.
+//
+//	Survey.ExpectSelect("Select a language:").
+//		Press(surveyexpect.MustParseKeys("Eng<DOWN><ENTER>")...)
+func MustParseKeys(notation string) []Key {
+	keys, err := ParseKeys(notation)
+	if err != nil {
+		panic(err)
+	}
+
+	return keys
+}
+
+func parseKey(name string) (Key, error) {
+	upper := strings.ToUpper(name)
+
+	for _, n := range keyNames {
+		if n.name == upper {
+			return n.key, nil
+		}
+	}
+
+	if strings.HasPrefix(upper, "ESC") {
+		return EscapeSequence(name[len("ESC"):]), nil
+	}
+
+	if c := strings.TrimPrefix(upper, "CTRL+"); c != upper && len(c) == 1 && c[0] >= 'A' && c[0] <= 'Z' {
+		return Key(rune(c[0] - 'A' + 1)), nil
+	}
+
+	return "", fmt.Errorf("%w: unknown key <%s>", ErrInvalidKeyNotation, name)
+}
+
+// FormatKeys represents a sequence of keys in the readable notation that ParseKeys understands.
+//
+//	surveyexpect.FormatKeys([]surveyexpect.Key{"hello", surveyexpect.KeyTab}) // hello<TAB>
+func FormatKeys(keys []Key) string {
+	var sb strings.Builder
+
+	for _, k := range keys {
+		sb.WriteString(formatKey(k))
+	}
+
+	return sb.String()
+}
+
+func formatKey(k Key) string {
+	if name, ok := keyName(k); ok {
+		return name
+	}
+
+	if strings.HasPrefix(string(k), string(KeyEsc)) {
+		return fmt.Sprintf("<ESC%s>", k[len(KeyEsc):])
+	}
+
+	var sb strings.Builder
+
+	for _, r := range string(k) {
+		switch name, ok := keyName(Key(r)); {
+		case ok && r != ' ':
+			sb.WriteString(name)
+
+		case r < ' ':
+			_, _ = fmt.Fprintf(&sb, "<CTRL+%c>", r+'A'-1)
+
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
+
+func keyName(k Key) (string, bool) {
+	for _, n := range keyNames {
+		if n.key == k {
+			return fmt.Sprintf("<%s>", n.name), true
+		}
+	}
+
+	return "", false
+}
+
+// PressAction sends a sequence of keys.
+type PressAction struct {
+	keys []Key
+}
+
+// Do runs the step.
+func (a *PressAction) Do(c Console) error {
+	for i, k := range a.keys {
+		// ESC is only taken as a key when nothing follows it in the input.
+		if i > 0 && a.keys[i-1] == KeyEsc {
+			<-WaitForReaction()
+		}
+
+		c.Send(string(k)) //nolint: errcheck,gosec
+	}
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (a *PressAction) String() string {
+	return fmt.Sprintf("press %q", FormatKeys(a.keys))
+}
+
+func pressKeys(keys ...Key) *PressAction {
+	return &PressAction{keys: keys}
+}
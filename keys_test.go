@@ -0,0 +1,129 @@
+package surveyexpect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.nhat.io/surveyexpect"
+)
+
+func TestParseKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario      string
+		notation      string
+		expected      []surveyexpect.Key
+		expectedError string
+	}{
+		{
+			scenario: "empty",
+		},
+		{
+			scenario: "text",
+			notation: "hello world",
+			expected: []surveyexpect.Key{"hello world"},
+		},
+		{
+			scenario: "text and keys",
+			notation: "hello<TAB><down><Enter>",
+			expected: []surveyexpect.Key{"hello", surveyexpect.KeyTab, surveyexpect.KeyDown, surveyexpect.KeyEnter},
+		},
+		{
+			scenario: "control keys",
+			notation: "<CTRL+A>x<ctrl+k>",
+			expected: []surveyexpect.Key{surveyexpect.KeyCtrlA, "x", surveyexpect.KeyCtrlK},
+		},
+		{
+			scenario: "function keys",
+			notation: "<F1><F12>",
+			expected: []surveyexpect.Key{surveyexpect.KeyF1, surveyexpect.KeyF12},
+		},
+		{
+			scenario: "escape sequence",
+			notation: "<ESC><ESC[1;5C>",
+			expected: []surveyexpect.Key{surveyexpect.KeyEsc, surveyexpect.EscapeSequence("[1;5C")},
+		},
+		{
+			scenario: "less than",
+			notation: "a <LT> b<ENTER>",
+			expected: []surveyexpect.Key{"a < b", surveyexpect.KeyEnter},
+		},
+		{
+			scenario:      "unknown key",
+			notation:      "hello<WORLD>",
+			expectedError: "invalid key notation: unknown key <WORLD>",
+		},
+		{
+			scenario:      "missing closing bracket",
+			notation:      "hello<TAB",
+			expectedError: `invalid key notation: missing ">" in "<TAB"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			keys, err := surveyexpect.ParseKeys(tc.notation)
+
+			assert.Equal(t, tc.expected, keys)
+
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMustParseKeys_Panic(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() {
+		surveyexpect.MustParseKeys("<WORLD>")
+	})
+}
+
+func TestFormatKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario string
+		keys     []surveyexpect.Key
+		expected string
+	}{
+		{
+			scenario: "text and keys",
+			keys:     []surveyexpect.Key{"hello", surveyexpect.KeyTab, surveyexpect.KeyDown, surveyexpect.KeyEnter},
+			expected: "hello<TAB><DOWN><ENTER>",
+		},
+		{
+			scenario: "space",
+			keys:     []surveyexpect.Key{"a b", surveyexpect.KeySpace},
+			expected: "a b<SPACE>",
+		},
+		{
+			scenario: "control characters in text",
+			keys:     []surveyexpect.Key{"a\tb\x18<"},
+			expected: "a<TAB>b<CTRL+X><LT>",
+		},
+		{
+			scenario: "escape sequence",
+			keys:     []surveyexpect.Key{surveyexpect.EscapeSequence("[1;5C")},
+			expected: "<ESC[1;5C>",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, surveyexpect.FormatKeys(tc.keys))
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package surveyexpect
+
+import (
+	"regexp"
+	"strings"
+)
+
+// inputHintRegex matches the hint and the default value that are rendered between the message and the text.
+var inputHintRegex = regexp.MustCompile(`^(\[[^]]*] )?(\([^)]*\) )?`)
+
+// multilineHint is rendered after the message of a multiline prompt, the text starts right after it.
+const multilineHint = "[Enter 2 empty lines to finish]"
+
+// inputLine is the text that is being edited in an input prompt, as it is rendered on the screen.
+type inputLine struct {
+	text string
+	// cursor is the position of the cursor in the text, starting from 0.
+	cursor int
+}
+
+// readInputLine reads the text that is being edited and the position of the cursor from the screen. The spaces at the
+// end of the text are not visible, so they are not a part of the text.
+func readInputLine(c Console, message string) (inputLine, error) {
+	s, err := readScreen(c)
+	if err != nil {
+		return inputLine{}, err
+	}
+
+	return parseInputLine(s, message), nil
+}
+
+func parseInputLine(s Screen, message string) inputLine {
+	// The prompt is the last line that starts with the question icon.
+	start := -1
+
+	for i, l := range s.lines {
+		if strings.HasPrefix(l, "? ") {
+			start = i
+		}
+	}
+
+	if start < 0 {
+		return inputLine{}
+	}
+
+	// The text is wrapped when it is longer than the screen.
+	var sb strings.Builder
+
+	for i := start; i < len(s.lines); i++ {
+		l := []rune(s.lines[i])
+
+		if i < len(s.lines)-1 && len(l) < s.width {
+			l = append(l, []rune(strings.Repeat(" ", s.width-len(l)))...)
+		}
+
+		sb.WriteString(string(l))
+	}
+
+	rendered := sb.String()
+	offset := 0
+
+	if i := strings.Index(rendered, message+" "); i >= 0 {
+		offset = len([]rune(rendered[:i+len(message)+1]))
+	}
+
+	rest := string([]rune(rendered)[offset:])
+	hint := inputHintRegex.FindString(rest)
+	offset += len([]rune(hint))
+	text := rest[len(hint):]
+
+	// The suggestions are listed after the text.
+	if i := strings.Index(text, " [Use arrows"); i >= 0 {
+		text = text[:i]
+	}
+
+	return inputLine{
+		text:   strings.TrimRight(text, " "),
+		cursor: (s.cursorY-start)*s.width + s.cursorX - offset,
+	}
+}
+
+// readMultilineText reads the lines that are entered in a multiline prompt, and the line that is being typed, from the
+// screen. The empty lines at the end are not visible, so they are not a part of the text.
+func readMultilineText(c Console, message string) (string, error) {
+	s, err := readScreen(c)
+	if err != nil {
+		return "", err
+	}
+
+	return parseMultilineText(s, message), nil
+}
+
+func parseMultilineText(s Screen, message string) string {
+	end := s.cursorY + 1
+	if end > len(s.lines) {
+		end = len(s.lines)
+	}
+
+	// The lines of the text may start with the question icon too, so the prompt is the last one that shows the message.
+	start := -1
+
+	for y := end - 1; y >= 0; y-- {
+		if strings.HasPrefix(s.lines[y], "? ") && strings.Contains(s.lines[y], message) {
+			start = y
+
+			break
+		}
+	}
+
+	if start < 0 {
+		return ""
+	}
+
+	// A line that is longer than the screen is wrapped.
+	lines := make([]string, 0, end-start)
+
+	var sb strings.Builder
+
+	for y := start; y < end; y++ {
+		sb.WriteString(s.lines[y])
+
+		if y == end-1 || len([]rune(s.lines[y])) < s.width {
+			lines = append(lines, sb.String())
+			sb.Reset()
+		}
+	}
+
+	// The first line is typed right after the hint.
+	if i := strings.Index(lines[0], multilineHint); i >= 0 {
+		lines[0] = lines[0][i+len(multilineHint):]
+	} else {
+		lines[0] = ""
+	}
+
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
@@ -0,0 +1,499 @@
+package surveyexpect
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	selectFocusIcon    = "> "
+	selectBlurIcon     = "  "
+	selectMarkedIcon   = "[x]"
+	selectUnmarkedIcon = "[ ]"
+
+	descriptionSeparator = " - "
+)
+
+// renderedOption is an option of a rendered select or multiselect list.
+type renderedOption struct {
+	value       string
+	highlighted bool
+	checked     bool
+}
+
+// parseRenderedOptions parses the options of a rendered select or multiselect list. The first line is the message.
+func parseRenderedOptions(rendered string, multiselect bool) []renderedOption {
+	lines := strings.Split(strings.ReplaceAll(rendered, "\r\n", "\n"), "\n")
+	options := make([]renderedOption, 0, len(lines))
+
+	for _, l := range lines[1:] {
+		if len(l) < len(selectFocusIcon) {
+			continue
+		}
+
+		if !strings.HasPrefix(l, selectFocusIcon) && !strings.HasPrefix(l, selectBlurIcon) {
+			continue
+		}
+
+		o := renderedOption{
+			highlighted: strings.HasPrefix(l, selectFocusIcon),
+			value:       l[len(selectFocusIcon):],
+		}
+
+		if multiselect {
+			switch {
+			case strings.HasPrefix(o.value, selectMarkedIcon):
+				o.checked = true
+
+			case !strings.HasPrefix(o.value, selectUnmarkedIcon):
+				continue
+			}
+
+			o.value = strings.TrimPrefix(o.value[len(selectMarkedIcon):], "  ")
+		}
+
+		options = append(options, o)
+	}
+
+	return options
+}
+
+// optionTexts are the descriptions of the options of a select or multiselect list by their labels. Survey renders an
+// option with a description as the label, " - " and the description, so an option is only found by its label when the
+// description is known. A nil optionTexts knows no description.
+type optionTexts struct {
+	descriptions map[string]string
+
+	mu sync.Mutex
+}
+
+func newOptionTexts() *optionTexts {
+	return &optionTexts{descriptions: make(map[string]string)}
+}
+
+// describe adds the descriptions of the options by their labels.
+func (t *optionTexts) describe(descriptions map[string]string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for label, description := range descriptions {
+		t.descriptions[label] = description
+	}
+}
+
+// text returns the text that survey renders for the option.
+func (t *optionTexts) text(label string) string {
+	if t == nil {
+		return label
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if d, ok := t.descriptions[label]; ok {
+		return label + descriptionSeparator + d
+	}
+
+	return label
+}
+
+// isLabel checks whether the rendered value is the label, with its description when it is known.
+func (t *optionTexts) isLabel(value, label string) bool {
+	return value == t.text(label)
+}
+
+// matchLabels checks whether the rendered values are the labels, in any order unless it is ordered.
+func (t *optionTexts) matchLabels(values, labels []string, ordered bool) bool {
+	if len(values) != len(labels) {
+		return false
+	}
+
+	used := make([]bool, len(values))
+
+	for i, l := range labels {
+		if ordered {
+			if !t.isLabel(values[i], l) {
+				return false
+			}
+
+			continue
+		}
+
+		found := false
+
+		for j, v := range values {
+			if !used[j] && t.isLabel(v, l) {
+				used[j], found = true, true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func highlightedOption(options []renderedOption) string {
+	for _, o := range options {
+		if o.highlighted {
+			return o.value
+		}
+	}
+
+	return ""
+}
+
+func checkedOptions(options []renderedOption) []string {
+	result := make([]string, 0, len(options))
+
+	for _, o := range options {
+		if o.checked {
+			result = append(result, o.value)
+		}
+	}
+
+	return result
+}
+
+// String represents the option as it is rendered.
+func (o renderedOption) String(multiselect bool) string {
+	var sb strings.Builder
+
+	if o.highlighted {
+		sb.WriteString(selectFocusIcon)
+	} else {
+		sb.WriteString(selectBlurIcon)
+	}
+
+	if multiselect {
+		if o.checked {
+			sb.WriteString(selectMarkedIcon)
+		} else {
+			sb.WriteString(selectUnmarkedIcon)
+		}
+
+		sb.WriteString("  ")
+	}
+
+	sb.WriteString(o.value)
+
+	return sb.String()
+}
+
+func highlightedIndex(options []renderedOption) int {
+	for i, o := range options {
+		if o.highlighted {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func formatOptions(options []renderedOption, multiselect bool) string {
+	lines := make([]string, 0, len(options))
+
+	for _, o := range options {
+		lines = append(lines, o.String(multiselect))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// optionList is a select, multiselect or suggestion list that is rendered on the screen.
+type optionList struct {
+	// header is the question that is rendered above the options, including the filter and the hint.
+	header string
+	// options are the visible options, in the rendered order.
+	options []renderedOption
+}
+
+// filter returns the text that is rendered between the message and the hint.
+func (l optionList) filter(message string) string {
+	rest := l.header
+
+	if i := strings.Index(rest, message); i >= 0 {
+		rest = rest[i+len(message):]
+	}
+
+	if i := strings.Index(rest, "[Use arrows"); i >= 0 {
+		rest = rest[:i]
+	}
+
+	return strings.TrimSpace(rest)
+}
+
+// readOptionList reads the select, multiselect or suggestion list from the screen.
+func readOptionList(c Console, multiselect bool) (optionList, error) {
+	s, err := readScreen(c)
+	if err != nil {
+		return optionList{}, err
+	}
+
+	return parseOptionList(s.lines, multiselect), nil
+}
+
+func parseOptionList(lines []string, multiselect bool) optionList {
+	// The list is rendered right after the question, which is the last line that starts with the question icon.
+	start := 0
+
+	for i, l := range lines {
+		if strings.HasPrefix(l, "? ") {
+			start = i
+		}
+	}
+
+	if start >= len(lines) {
+		return optionList{}
+	}
+
+	var header strings.Builder
+
+	// The question is wrapped when it is longer than the screen.
+	for _, l := range lines[start:] {
+		if header.Len() > 0 && (strings.HasPrefix(l, selectFocusIcon) || strings.HasPrefix(l, selectBlurIcon)) {
+			break
+		}
+
+		header.WriteString(l)
+	}
+
+	return optionList{
+		header:  header.String(),
+		options: parseRenderedOptions(strings.Join(lines[start:], "\n"), multiselect),
+	}
+}
+
+// readOptions reads the options of the select, multiselect or suggestion list from the screen.
+func readOptions(c Console, multiselect bool) ([]renderedOption, error) {
+	l, err := readOptionList(c, multiselect)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.options, nil
+}
+
+// optionTarget is an option to look for in a rendered list, either by its label or by its index in the options of the
+// list.
+type optionTarget struct {
+	label string
+	index int
+	texts *optionTexts
+}
+
+// find finds the target among the visible options, start is the index of the first visible option in the list, or -1
+// when it is unknown.
+func (t optionTarget) find(options []renderedOption, start int) int {
+	if t.index >= 0 {
+		if start >= 0 && t.index >= start && t.index < start+len(options) {
+			return t.index - start
+		}
+
+		return -1
+	}
+
+	for i, o := range options {
+		if t.texts.isLabel(o.value, t.label) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// String represents the target as a string.
+func (t optionTarget) String() string {
+	if t.index >= 0 {
+		return fmt.Sprintf("#%d", t.index)
+	}
+
+	return fmt.Sprintf("%q", t.label)
+}
+
+func optionLabel(label string) optionTarget {
+	return optionTarget{label: label, index: -1}
+}
+
+// option returns the target option by its label, with its description when it is known.
+func (t *optionTexts) option(label string) optionTarget {
+	return optionTarget{label: label, index: -1, texts: t}
+}
+
+func optionIndex(index int) optionTarget {
+	return optionTarget{index: index}
+}
+
+// highlightOption moves the cursor to the target option and confirms that it is highlighted. The list is scrolled when
+// the option is not visible.
+func highlightOption(c Console, target optionTarget, multiselect bool) (renderedOption, error) {
+	options, i, err := scrollToOption(c, target, multiselect)
+	if err != nil {
+		return renderedOption{}, err
+	}
+
+	moveCursor(c, i-highlightedIndex(options))
+
+	return expectHighlighted(c, nil, options[i].value, multiselect)
+}
+
+// firstPageStart returns 0 when the visible options are the first page of the list, or -1 when it is unknown. Survey
+// keeps the highlighted option in the middle of the page unless the page is the first or the last one, so the page is
+// the first one when the highlighted option is in the upper half.
+func firstPageStart(options []renderedOption) int {
+	if highlightedIndex(options) < len(options)/2 {
+		return 0
+	}
+
+	return -1
+}
+
+// scrollToFirstPage moves the cursor up until the first page of the list is visible. It returns the visible options and
+// how many times the cursor is moved up.
+func scrollToFirstPage(c Console, options []renderedOption, multiselect bool) ([]renderedOption, int, error) {
+	current := highlightedIndex(options)
+	moved := 0
+
+	// Moving up by the row of the highlighted option never goes past the first option, so the cursor does not wrap.
+	for current > 0 && firstPageStart(options) < 0 {
+		moveCursor(c, -current)
+		moved += current
+
+		var err error
+
+		if options, err = readOptions(c, multiselect); err != nil {
+			return nil, 0, err
+		}
+
+		current = highlightedIndex(options)
+	}
+
+	return options, moved, nil
+}
+
+// visibleStart reads the visible options and finds the index of the first one in the list. Survey does not render the
+// index, so the cursor may be moved up to the first page and back, which renders the same options again.
+func visibleStart(c Console, multiselect bool) ([]renderedOption, int, error) {
+	options, err := readOptions(c, multiselect)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if start := firstPageStart(options); start >= 0 {
+		return options, start, nil
+	}
+
+	top, moved, err := scrollToFirstPage(c, options, multiselect)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	moveCursor(c, moved)
+
+	return options, moved + highlightedIndex(top) - highlightedIndex(options), nil
+}
+
+// scrollToOption scrolls the list until the target option is visible. It returns the visible options and the position
+// of the target among them. Unless the option is already visible, the list is scrolled up to the first page, then down
+// page by page until the option shows up or the cursor wraps around to the first option. When the option is not found,
+// the cursor is moved back to where it was.
+func scrollToOption(c Console, target optionTarget, multiselect bool) ([]renderedOption, int, error) {
+	visible, err := readOptions(c, multiselect)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if i := target.find(visible, firstPageStart(visible)); i >= 0 {
+		return visible, i, nil
+	}
+
+	notFound := func() error {
+		return fmt.Errorf("%w: %s, visible options:\n%s", ErrOptionNotFound, target, formatOptions(visible, multiselect))
+	}
+
+	options, moved, err := scrollToFirstPage(c, visible, multiselect)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	current := highlightedIndex(options)
+	if current < 0 {
+		return nil, 0, notFound()
+	}
+
+	// The index of the option that was highlighted, and of the one that is highlighted now.
+	origin := moved + current
+	pos := current
+	start := 0
+
+	for {
+		if i := target.find(options, start); i >= 0 {
+			return options, i, nil
+		}
+
+		// Move past the last visible option to scroll the list.
+		next := start + len(options)
+		moveCursor(c, next-pos)
+
+		if options, err = readOptions(c, multiselect); err != nil {
+			return nil, 0, err
+		}
+
+		current = highlightedIndex(options)
+
+		// The first option is only highlighted on the first row, so the cursor wrapped around after the last option.
+		if current <= 0 {
+			moveCursor(c, origin)
+
+			return nil, 0, notFound()
+		}
+
+		pos, start = next, next-current
+	}
+}
+
+// findOption reads the multiselect list from the screen and finds the option, the list is scrolled when the option is
+// not visible.
+func findOption(c Console, target optionTarget) (renderedOption, error) {
+	options, err := readOptions(c, true)
+	if err != nil {
+		return renderedOption{}, err
+	}
+
+	if i := target.find(options, -1); i >= 0 {
+		return options[i], nil
+	}
+
+	return highlightOption(c, target, true)
+}
+
+// expectHighlighted reads the list from the screen and confirms that the option is highlighted, the label is matched
+// with its description when it is known.
+func expectHighlighted(c Console, texts *optionTexts, label string, multiselect bool) (renderedOption, error) {
+	options, err := readOptions(c, multiselect)
+	if err != nil {
+		return renderedOption{}, err
+	}
+
+	if i := highlightedIndex(options); i >= 0 && texts.isLabel(options[i].value, label) {
+		return options[i], nil
+	}
+
+	return renderedOption{}, fmt.Errorf("%w: expected %q, got:\n%s", ErrUnexpectedHighlight, label, formatOptions(options, multiselect))
+}
+
+// moveCursor presses ARROW DOWN or ARROW UP to move the cursor by the given offset.
+func moveCursor(c Console, offset int) {
+	key := pressArrowDown()
+
+	if offset < 0 {
+		key, offset = pressArrowUp(), -offset
+	}
+
+	c.Send(strings.Repeat(string(key.code), offset)) //nolint: errcheck,gosec
+}
@@ -0,0 +1,203 @@
+package surveyexpect
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/Netflix/go-expect"
+)
+
+var (
+	_ Matcher = (*exactMatcher)(nil)
+	_ Matcher = (*regexpMatcher)(nil)
+
+	globWildcard = regexp.MustCompile(`\\\*`)
+	spaces       = regexp.MustCompile(`\s+`)
+)
+
+// Matcher matches the message of a prompt in the output of the survey.
+type Matcher interface {
+	// Match finds the message in the output.
+	Match(output string) (MessageMatch, bool)
+
+	// String represents the matcher as a string.
+	String() string
+}
+
+// MessageMatch is the text that matches the message of a prompt.
+type MessageMatch struct {
+	// Text is the matched text.
+	Text string
+
+	// Captures are the named groups of the match.
+	Captures map[string]string
+}
+
+// Exact matches the message as it is.
+//
+//	Survey.ExpectInputMatch(surveyexpect.Exact("Enter your name:"))
+func Exact(message string) Matcher {
+	return &exactMatcher{message: message}
+}
+
+// Regexp matches the message with a regular expression, the named groups are captured. It panics if the expression
+// cannot be parsed. The output is matched as soon as it is read, so the expression should end with a literal.
+//
+//	Survey.ExpectConfirmMatch(surveyexpect.Regexp(`Found (?P<count>\d+) files, continue\?`))
+func Regexp(pattern string) Matcher {
+	return &regexpMatcher{
+		re:          regexp.MustCompile(pattern),
+		description: fmt.Sprintf("regexp %q", pattern),
+	}
+}
+
+// Glob matches the message with a pattern where * matches any text within the line.
+//
+//	Survey.ExpectInputMatch(surveyexpect.Glob("Save to /tmp/*/report.txt?"))
+func Glob(pattern string) Matcher {
+	re := globWildcard.ReplaceAllString(regexp.QuoteMeta(pattern), `[^\r\n]*`)
+
+	return &regexpMatcher{
+		re:          regexp.MustCompile(re),
+		description: fmt.Sprintf("glob %q", pattern),
+	}
+}
+
+// CaseInsensitive matches the message regardless of the case.
+//
+//	Survey.ExpectInputMatch(surveyexpect.CaseInsensitive("enter your name:"))
+func CaseInsensitive(message string) Matcher {
+	return &regexpMatcher{
+		re:          regexp.MustCompile(`(?i)` + regexp.QuoteMeta(message)),
+		description: fmt.Sprintf("case insensitive %q", message),
+	}
+}
+
+// NormalizedSpace matches the message where any sequence of spaces, tabs or new lines matches any other.
+//
+//	Survey.ExpectInputMatch(surveyexpect.NormalizedSpace("Enter  your name:"))
+func NormalizedSpace(message string) Matcher {
+	fields := strings.Fields(message)
+	for i, f := range fields {
+		fields[i] = regexp.QuoteMeta(f)
+	}
+
+	return &regexpMatcher{
+		re:          regexp.MustCompile(strings.Join(fields, `\s+`)),
+		description: fmt.Sprintf("normalized space %q", spaces.ReplaceAllString(strings.TrimSpace(message), " ")),
+	}
+}
+
+type exactMatcher struct {
+	message string
+}
+
+// Match finds the message in the output.
+func (m *exactMatcher) Match(output string) (MessageMatch, bool) {
+	if !strings.Contains(output, m.message) {
+		return MessageMatch{}, false
+	}
+
+	return MessageMatch{Text: m.message}, true
+}
+
+// String represents the matcher as a string.
+func (m *exactMatcher) String() string {
+	return fmt.Sprintf("%q", m.message)
+}
+
+type regexpMatcher struct {
+	re          *regexp.Regexp
+	description string
+}
+
+// Match finds the message in the output.
+func (m *regexpMatcher) Match(output string) (MessageMatch, bool) {
+	found := m.re.FindStringSubmatch(output)
+	if found == nil {
+		return MessageMatch{}, false
+	}
+
+	match := MessageMatch{Text: found[0]}
+
+	for i, name := range m.re.SubexpNames() {
+		if name == "" {
+			continue
+		}
+
+		if match.Captures == nil {
+			match.Captures = make(map[string]string)
+		}
+
+		match.Captures[name] = found[i]
+	}
+
+	return match, true
+}
+
+// String represents the matcher as a string.
+func (m *regexpMatcher) String() string {
+	return m.description
+}
+
+// sameMatcher checks whether two matchers are the same.
+func sameMatcher(a, b Matcher) bool {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) || !reflect.TypeOf(a).Comparable() {
+		return false
+	}
+
+	return a == b
+}
+
+// consoleMatcher matches the message of a prompt in the console.
+type consoleMatcher struct {
+	message Matcher
+}
+
+// Match finds the message in the buffer of the console.
+func (m *consoleMatcher) Match(v interface{}) bool {
+	buf, ok := v.(*bytes.Buffer)
+	if !ok {
+		return false
+	}
+
+	_, ok = m.message.Match(buf.String())
+
+	return ok
+}
+
+// Criteria returns the matcher of the message.
+func (m *consoleMatcher) Criteria() interface{} {
+	return m.message
+}
+
+// expectMessages expects any of the messages in the console.
+func expectMessages(messages ...Matcher) expect.ExpectOpt {
+	return func(opts *expect.ExpectOpts) error {
+		for _, m := range messages {
+			opts.Matchers = append(opts.Matchers, &consoleMatcher{message: m})
+		}
+
+		return nil
+	}
+}
+
+// lastMatch finds the message that is matched at the end of the output. The longest one wins when a message ends with
+// another one.
+func lastMatch(output string, messages ...Matcher) (int, MessageMatch) {
+	asked := -1
+
+	var found MessageMatch
+
+	for i, m := range messages {
+		match, ok := m.Match(output)
+		if ok && strings.HasSuffix(output, match.Text) && (asked < 0 || len(match.Text) > len(found.Text)) {
+			asked, found = i, match
+		}
+	}
+
+	return asked, found
+}
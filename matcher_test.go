@@ -0,0 +1,164 @@
+package surveyexpect_test
+
+import (
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/stretchr/testify/assert"
+
+	"go.nhat.io/surveyexpect"
+	"go.nhat.io/surveyexpect/options"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario      string
+		matcher       surveyexpect.Matcher
+		output        string
+		expectedOK    bool
+		expectedMatch surveyexpect.MessageMatch
+		expectedStr   string
+	}{
+		{
+			scenario:      "exact",
+			matcher:       surveyexpect.Exact("Enter your name:"),
+			output:        "? Enter your name: ",
+			expectedOK:    true,
+			expectedMatch: surveyexpect.MessageMatch{Text: "Enter your name:"},
+			expectedStr:   `"Enter your name:"`,
+		},
+		{
+			scenario:    "exact does not match",
+			matcher:     surveyexpect.Exact("Enter your name:"),
+			output:      "? enter your name: ",
+			expectedStr: `"Enter your name:"`,
+		},
+		{
+			scenario:   "regexp",
+			matcher:    surveyexpect.Regexp(`Found (?P<count>\d+) files, (continue)\?`),
+			output:     "? Found 3 files, continue? ",
+			expectedOK: true,
+			expectedMatch: surveyexpect.MessageMatch{
+				Text:     "Found 3 files, continue?",
+				Captures: map[string]string{"count": "3"},
+			},
+			expectedStr: `regexp "Found (?P<count>\\d+) files, (continue)\\?"`,
+		},
+		{
+			scenario:      "glob",
+			matcher:       surveyexpect.Glob("Save to /tmp/*/report.txt?"),
+			output:        "? Save to /tmp/go-build123/report.txt? ",
+			expectedOK:    true,
+			expectedMatch: surveyexpect.MessageMatch{Text: "Save to /tmp/go-build123/report.txt?"},
+			expectedStr:   `glob "Save to /tmp/*/report.txt?"`,
+		},
+		{
+			scenario:    "glob does not match another line",
+			matcher:     surveyexpect.Glob("Save to /tmp/*/report.txt?"),
+			output:      "? Save to /tmp/\n/report.txt? ",
+			expectedStr: `glob "Save to /tmp/*/report.txt?"`,
+		},
+		{
+			scenario:      "case insensitive",
+			matcher:       surveyexpect.CaseInsensitive("enter your name:"),
+			output:        "? Enter Your Name: ",
+			expectedOK:    true,
+			expectedMatch: surveyexpect.MessageMatch{Text: "Enter Your Name:"},
+			expectedStr:   `case insensitive "enter your name:"`,
+		},
+		{
+			scenario:      "normalized space",
+			matcher:       surveyexpect.NormalizedSpace(" Enter  your\tname: "),
+			output:        "? Enter your\r\nname: ",
+			expectedOK:    true,
+			expectedMatch: surveyexpect.MessageMatch{Text: "Enter your\r\nname:"},
+			expectedStr:   `normalized space "Enter your name:"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			match, ok := tc.matcher.Match(tc.output)
+
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedMatch, match)
+			assert.Equal(t, tc.expectedStr, tc.matcher.String())
+		})
+	}
+}
+
+func TestMatcher_Survey(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectConfirmMatch(surveyexpect.Regexp(`Found (?P<count>\d+) files, continue\?`)).
+			Yes()
+
+		s.ExpectInputMatch(surveyexpect.Glob("Save the report to /tmp/*/report.txt:")).
+			Answer("yes")
+
+		s.ExpectSelectMatch(surveyexpect.CaseInsensitive("select a region:")).
+			Choose("us")
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var (
+			proceed bool
+			save    string
+			region  string
+		)
+
+		err := survey.AskOne(&survey.Confirm{Message: "Found 42 files, continue?"}, &proceed, options.WithStdio(stdio))
+		assert.NoError(t, err)
+		assert.True(t, proceed)
+
+		err = survey.AskOne(&survey.Input{Message: "Save the report to /tmp/build-7f3a/report.txt:"}, &save, options.WithStdio(stdio))
+		assert.NoError(t, err)
+		assert.Equal(t, "yes", save)
+
+		err = survey.AskOne(&survey.Select{Message: "Select a Region:", Options: []string{"eu", "us"}}, &region, options.WithStdio(stdio))
+		assert.NoError(t, err)
+		assert.Equal(t, "us", region)
+	})
+
+	assert.Equal(t, map[string]string{"count": "42"}, s.Captures())
+}
+
+func TestMatcher_InAnyOrder(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.MatchExpectationsInOrder(false)
+
+		s.ExpectInputMatch(surveyexpect.Regexp(`Name of plugin (?P<plugin>\w+):`)).
+			Answer("alpha")
+
+		s.ExpectConfirmMatch(surveyexpect.NormalizedSpace("Enable the plugin?")).
+			Yes()
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var (
+			enabled bool
+			name    string
+		)
+
+		err := survey.AskOne(&survey.Confirm{Message: "Enable  the plugin?"}, &enabled, options.WithStdio(stdio))
+		assert.NoError(t, err)
+		assert.True(t, enabled)
+
+		err = survey.AskOne(&survey.Input{Message: "Name of plugin git:"}, &name, options.WithStdio(stdio))
+		assert.NoError(t, err)
+		assert.Equal(t, "alpha", name)
+	})
+
+	assert.Equal(t, map[string]string{"plugin": "git"}, s.Captures())
+}
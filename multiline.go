@@ -14,8 +14,7 @@ var (
 type MultilinePrompt struct {
 	*basePrompt
 
-	message string
-	answer  Step
+	answer Step
 }
 
 // Interrupt marks the answer is interrupted.
@@ -26,42 +25,140 @@ func (p *MultilinePrompt) Interrupt() {
 	p.lock()
 	defer p.unlock()
 
-	p.answer = pressInterrupt()
+	p.answer = retryAnswer(p.answer, pressInterrupt(), waitForCursorTwice)
 	p.timesLocked(1)
 }
 
-// Answer sets the answer to the input prompt.
+// Answer sets the answer to the input prompt. If the previous answer expects a validation error, the answer is given
+// when the prompt is asked again.
 //
 //	Survey.ExpectMultiline("Enter your message:").
 //		Answer("hello world")
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Answer("").ExpectValidationError("Value is required").
+//		Answer("hello world")
 func (p *MultilinePrompt) Answer(answer string) *MultilineAnswer {
 	p.lock()
 	defer p.unlock()
 
 	a := newMultilineAnswer(p, answer)
-	p.answer = a
+	p.answer = retryAnswer(p.answer, a, waitForCursorTwice)
+
+	return a
+}
+
+// AnswerSequence gives a different answer each time the prompt is asked, the first answer for the first time, the
+// second one for the second time and so on. The prompt is expected to be asked as many times as the answers.
+//
+//	Survey.ExpectMultiline("Enter a description:").
+//		AnswerSequence("alpha", "beta", "gamma")
+func (p *MultilinePrompt) AnswerSequence(answers ...string) *MultilinePrompt {
+	p.lock()
+	defer p.unlock()
+
+	steps := make([]Step, 0, len(answers))
+
+	for _, answer := range answers {
+		steps = append(steps, newMultilineAnswer(p, answer))
+	}
+
+	p.answer = retryAnswer(p.answer, sequenceAnswers(p.basePrompt, steps...), waitForCursorTwice)
+
+	return p
+}
+
+// Press answers the prompt by sending the keys, see ParseKeys for the notation. The keys are expected to end the
+// prompt, which takes two empty lines.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Press(surveyexpect.MustParseKeys("hello<ENTER>world<ENTER><ENTER><ENTER>")...)
+func (p *MultilinePrompt) Press(keys ...Key) *MultilinePrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.answer = retryAnswer(p.answer, pressKeys(keys...), waitForCursorTwice)
+
+	return p
+}
+
+// Type starts a sequence of steps by typing a string without ENTER, the line is edited until it is entered.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Type("helo").
+//		MoveLeft().
+//		Type("l").
+//		Enter().
+//		Submit()
+func (p *MultilinePrompt) Type(s string) *MultilineSteps {
+	p.lock()
+	defer p.unlock()
+
+	a := newMultilineSteps(p, typeAnswer(s))
+	p.answer = retryAnswer(p.answer, a, waitForCursorTwice)
+
+	return a
+}
+
+// TypeLine starts a sequence of steps by typing a line and sending the ENTER key.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		TypeLine("hello").
+//		TypeLine("").
+//		TypeLine("world").
+//		ExpectText("hello\n\nworld").
+//		Submit()
+func (p *MultilinePrompt) TypeLine(line string) *MultilineSteps {
+	p.lock()
+	defer p.unlock()
+
+	a := newMultilineSteps(p, enterLine(line))
+	p.answer = retryAnswer(p.answer, a, waitForCursorTwice)
 
 	return a
 }
 
+// ExpectAnswered expects the answer that survey renders below the message once the prompt is answered, after the
+// transformer of the question is applied.
+//
+//	Survey.ExpectMultiline("Enter a description:").
+//		ExpectAnswered("hello\nworld").
+//		Answer("hello\nworld")
+func (p *MultilinePrompt) ExpectAnswered(answer string) *MultilinePrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.expectAnsweredLocked(answer)
+
+	return p
+}
+
 // Do runs the step.
 func (p *MultilinePrompt) Do(c Console) error {
-	if _, err := c.ExpectString(p.message); err != nil {
+	if err := p.expectMessage(c); err != nil {
 		return err
 	}
 
-	_ = waitForCursorTwice(c) //nolint: errcheck
+	// The prompt is ready for the answer once it asks for the cursor position.
+	if err := waitForCursorTwice(c); err != nil {
+		return err
+	}
 
 	err := p.answer.Do(c)
 	if err != nil && !IsInterrupted(err) {
 		return err
 	}
 
+	if err == nil && !expectsRetry(p.answer) {
+		if err := p.readAnswer(c, false); err != nil {
+			return err
+		}
+	}
+
 	p.lock()
 	defer p.unlock()
 
-	p.repeatability--
-	p.totalCalls++
+	p.calledLocked()
 
 	return p.isDoneLocked(err)
 }
@@ -71,11 +168,20 @@ func (p *MultilinePrompt) String() string {
 	var sb stringsBuilder
 
 	sb.WriteLabelLinef("Expect", "Multiline Prompt").
-		WriteLabelLinef("Message", "%q", p.message).
-		WriteLabelLinef("Answer", p.answer.String())
+		WriteLabelLinef("Message", "%s", p.message)
 
-	if p.repeatability > 0 && (p.totalCalls != 0 || p.repeatability != 1) {
-		sb.WriteLinef("(called: %d time(s), remaining: %d time(s))", p.totalCalls, p.repeatability)
+	if p.question != "" {
+		sb.WriteLabelLinef("Question", "%q", p.question)
+	}
+
+	if p.answered != nil {
+		sb.WriteLabelLinef("Answered", "%q", *p.answered)
+	}
+
+	sb.WriteLabelLinef("Answer", p.answer.String())
+
+	if calls := p.callsString(); calls != "" {
+		sb.WriteLinef("%s", calls)
 	}
 
 	return sb.String()
@@ -110,11 +216,54 @@ func (p *MultilinePrompt) Times(i int) *MultilinePrompt {
 	return p
 }
 
+// Maybe indicates that the message may be asked once, or not at all.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Answer("hello world").
+//		Maybe()
+func (p *MultilinePrompt) Maybe() *MultilinePrompt {
+	return p.AtMost(1)
+}
+
+// AtLeast indicates that the message should be asked at least the indicated number of times.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Answer("hello world").
+//		AtLeast(2)
+func (p *MultilinePrompt) AtLeast(i int) *MultilinePrompt {
+	p.cardinality(i, anyTimes)
+
+	return p
+}
+
+// AtMost indicates that the message may be asked up to the indicated number of times, or not at all.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Answer("hello world").
+//		AtMost(3)
+func (p *MultilinePrompt) AtMost(i int) *MultilinePrompt {
+	p.cardinality(0, i)
+
+	return p
+}
+
+// AnyTimes indicates that the message may be asked any number of times, or not at all.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Answer("hello world").
+//		AnyTimes()
+func (p *MultilinePrompt) AnyTimes() *MultilinePrompt {
+	p.cardinality(0, anyTimes)
+
+	return p
+}
+
 // MultilineAnswer is an answer for password question.
 type MultilineAnswer struct {
-	parent      *MultilinePrompt
-	answer      string
-	interrupted bool
+	parent          *MultilinePrompt
+	answer          string
+	validationError string
+	interrupted     bool
 }
 
 // Do runs the step.
@@ -144,6 +293,10 @@ func (a *MultilineAnswer) Do(c Console) error {
 		}
 	}
 
+	if a.validationError != "" {
+		return expectValidationError(a.validationError).Do(c)
+	}
+
 	return nil
 }
 
@@ -153,6 +306,26 @@ func (a *MultilineAnswer) Interrupted() {
 	defer a.parent.unlock()
 
 	a.interrupted = true
+	a.validationError = ""
+}
+
+// ExpectValidationError expects the answer to be rejected by a validator, so the prompt is asked again.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Answer("").ExpectValidationError("Value is required").
+//		Answer("hello world")
+func (a *MultilineAnswer) ExpectValidationError(err string) *MultilinePrompt {
+	a.parent.lock()
+	defer a.parent.unlock()
+
+	a.validationError = err
+	a.interrupted = false
+
+	return a.parent
+}
+
+func (a *MultilineAnswer) expectsRetry() bool {
+	return a.validationError != ""
 }
 
 // String represents the answer as a string.
@@ -163,15 +336,221 @@ func (a *MultilineAnswer) String() string {
 
 	if a.interrupted {
 		_, _ = sb.WriteString(" and get interrupted")
+	} else if a.validationError != "" {
+		_, _ = fmt.Fprintf(&sb, " and get validation error %q", a.validationError)
 	}
 
 	return sb.String()
 }
 
-func newMultiline(parent *Survey, message string) *MultilinePrompt {
+// LineAction enters a line of a multiline prompt and waits for the prompt to read the next one.
+type LineAction struct {
+	line string
+}
+
+// Do runs the step.
+func (a *LineAction) Do(c Console) error {
+	c.SendLine(a.line) //nolint: errcheck,gosec
+
+	return waitForCursorTwice(c)
+}
+
+// String represents the answer as a string.
+func (a *LineAction) String() string {
+	if a.line == "" {
+		return pressEnter().String()
+	}
+
+	return fmt.Sprintf("type %q and press ENTER", a.line)
+}
+
+// SubmitLinesAction sends the two empty lines that end the text of a multiline prompt.
+type SubmitLinesAction struct{}
+
+// Do runs the step.
+func (a *SubmitLinesAction) Do(c Console) error {
+	if err := enterLine("").Do(c); err != nil {
+		return err
+	}
+
+	c.SendLine("") //nolint: errcheck,gosec
+
+	return nil
+}
+
+// String represents the answer as a string.
+func (a *SubmitLinesAction) String() string {
+	return "press ENTER twice"
+}
+
+// MultilineSteps is a sequence of steps to type and edit the lines of a multiline prompt.
+type MultilineSteps struct {
+	parent *MultilinePrompt
+	steps  *InlineSteps
+}
+
+func (a *MultilineSteps) append(steps ...Step) *MultilineSteps {
+	a.parent.lock()
+	defer a.parent.unlock()
+
+	a.steps.Append(steps...)
+
+	return a
+}
+
+// Type sends a string without ENTER.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		TypeLine("hello").
+//		Type("world").
+//		Enter().
+//		Submit()
+func (a *MultilineSteps) Type(s string) *MultilineSteps {
+	return a.append(typeAnswer(s))
+}
+
+// TypeLine types a line and sends the ENTER key, an empty line is kept in the text unless it is followed by another
+// one.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		TypeLine("hello").
+//		TypeLine("").
+//		TypeLine("world").
+//		Submit()
+func (a *MultilineSteps) TypeLine(line string) *MultilineSteps {
+	return a.append(enterLine(line))
+}
+
+// Enter sends the ENTER key to enter the line that is being typed, or an empty line when nothing is typed.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Type("hello").
+//		Enter().
+//		Submit()
+func (a *MultilineSteps) Enter() *MultilineSteps {
+	return a.append(enterLine(""))
+}
+
+// Press sends the keys, see ParseKeys for the notation. The ENTER key does not wait for the prompt to read the next
+// line, use Enter instead.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Type("world").
+//		Press(surveyexpect.KeyCtrlA).
+//		Type("hello ")
+func (a *MultilineSteps) Press(keys ...Key) *MultilineSteps {
+	return a.append(pressKeys(keys...))
+}
+
+// MoveLeft sends the ARROW LEFT key the indicated times to move the cursor in the line. Default is 1 when omitted.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Type("helo").
+//		MoveLeft().
+//		Type("l")
+func (a *MultilineSteps) MoveLeft(times ...int) *MultilineSteps {
+	return a.append(repeatStep(pressArrowLeft(), times...)...)
+}
+
+// MoveRight sends the ARROW RIGHT key the indicated times to move the cursor in the line. Default is 1 when omitted.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Type("hello").
+//		Home().
+//		MoveRight(4)
+func (a *MultilineSteps) MoveRight(times ...int) *MultilineSteps {
+	return a.append(repeatStep(pressArrowRight(), times...)...)
+}
+
+// Home sends the HOME key to move the cursor to the beginning of the line.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Type("world").
+//		Home().
+//		Type("hello ")
+func (a *MultilineSteps) Home() *MultilineSteps {
+	return a.append(pressHome())
+}
+
+// End sends the END key to move the cursor to the end of the line.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Type("hello").
+//		Home().
+//		End()
+func (a *MultilineSteps) End() *MultilineSteps {
+	return a.append(pressEnd())
+}
+
+// Backspace sends the BACKSPACE key the indicated times to delete the characters before the cursor. Default is 1 when
+// omitted.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Type("helllo").
+//		Backspace(2).
+//		Type("lo")
+func (a *MultilineSteps) Backspace(times ...int) *MultilineSteps {
+	return a.append(repeatStep(pressBackspace(), times...)...)
+}
+
+// ForwardDelete sends the DELETE key, KeyDelete, the indicated times to delete the characters under the cursor. Default
+// is 1 when omitted. Unlike the Delete steps of the other prompts, which send the DEL character that survey takes for
+// BACKSPACE, it sends the escape sequence of the key.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		Type("helllo").
+//		MoveLeft(3).
+//		ForwardDelete()
+func (a *MultilineSteps) ForwardDelete(times ...int) *MultilineSteps {
+	return a.append(repeatStep(pressKeys(KeyDelete), times...)...)
+}
+
+// ExpectText expects the text that is entered so far, including the line that is being typed, as it is shown on the
+// screen. The empty lines at the end are not visible, so they are not a part of the text.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		TypeLine("hello").
+//		Type("world").
+//		ExpectText("hello\nworld")
+func (a *MultilineSteps) ExpectText(text string) *MultilineSteps {
+	return a.append(expectText(a.parent.text, text))
+}
+
+// Submit sends the two empty lines that end the text and ends the sequence. The line that is being typed has to be
+// entered before.
+//
+//	Survey.ExpectMultiline("Enter your message:").
+//		TypeLine("hello world").
+//		Submit()
+func (a *MultilineSteps) Submit() {
+	a.append(&SubmitLinesAction{})
+	a.steps.Close()
+}
+
+// Do runs the step.
+func (a *MultilineSteps) Do(c Console) error {
+	return a.steps.Do(c)
+}
+
+// String represents the answer as a string.
+func (a *MultilineSteps) String() string {
+	return a.steps.String()
+}
+
+func newMultilineSteps(parent *MultilinePrompt, initialSteps ...Step) *MultilineSteps {
+	return &MultilineSteps{
+		parent: parent,
+		steps:  inlineSteps(initialSteps...),
+	}
+}
+
+func enterLine(line string) *LineAction {
+	return &LineAction{line: line}
+}
+
+func newMultiline(parent *Survey, message Matcher) *MultilinePrompt {
 	p := &MultilinePrompt{
-		basePrompt: &basePrompt{parent: parent},
-		message:    message,
+		basePrompt: &basePrompt{parent: parent, message: message},
 	}
 
 	p.answer = newMultilineAnswer(p, "")
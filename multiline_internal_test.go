@@ -9,7 +9,7 @@ import (
 func TestMultilinePrompt_Once(t *testing.T) {
 	t.Parallel()
 
-	p := newMultiline(&Survey{}, "").Once()
+	p := newMultiline(&Survey{}, Exact("")).Once()
 
 	assert.Equal(t, 1, p.repeatability)
 }
@@ -17,7 +17,7 @@ func TestMultilinePrompt_Once(t *testing.T) {
 func TestMultilinePrompt_Twice(t *testing.T) {
 	t.Parallel()
 
-	p := newMultiline(&Survey{}, "").Twice()
+	p := newMultiline(&Survey{}, Exact("")).Twice()
 
 	assert.Equal(t, 2, p.repeatability)
 }
@@ -25,7 +25,7 @@ func TestMultilinePrompt_Twice(t *testing.T) {
 func TestMultilinePrompt_Times(t *testing.T) {
 	t.Parallel()
 
-	p := newMultiline(&Survey{}, "").Times(5)
+	p := newMultiline(&Survey{}, Exact("")).Times(5)
 
 	assert.Equal(t, 5, p.repeatability)
 }
@@ -65,9 +65,9 @@ func TestMultilinePrompt_String(t *testing.T) {
 				basePrompt: &basePrompt{
 					repeatability: tc.repeatability,
 					totalCalls:    tc.totalCalls,
+					message:       Exact("Enter the password:"),
 				},
-				message: "Enter the password:",
-				answer:  noAnswer(),
+				answer: noAnswer(),
 			}
 
 			assert.Equal(t, tc.expected, p.String())
@@ -79,9 +79,10 @@ func TestMultilineAnswer_String(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		scenario    string
-		interrupted bool
-		expected    string
+		scenario        string
+		interrupted     bool
+		validationError string
+		expected        string
 	}{
 		{
 			scenario: "not interrupted",
@@ -92,6 +93,11 @@ func TestMultilineAnswer_String(t *testing.T) {
 			interrupted: true,
 			expected:    `"password" and get interrupted`,
 		},
+		{
+			scenario:        "validation error",
+			validationError: "Value is required",
+			expected:        `"password" and get validation error "Value is required"`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -100,11 +106,29 @@ func TestMultilineAnswer_String(t *testing.T) {
 			t.Parallel()
 
 			a := &MultilineAnswer{
-				answer:      "password",
-				interrupted: tc.interrupted,
+				answer:          "password",
+				interrupted:     tc.interrupted,
+				validationError: tc.validationError,
 			}
 
 			assert.Equal(t, tc.expected, a.String())
 		})
 	}
 }
+
+func TestMultilineSteps_String(t *testing.T) {
+	t.Parallel()
+
+	p := newMultiline(&Survey{}, Exact("Enter your comment"))
+	p.TypeLine("hello").
+		TypeLine("").
+		Type("world").
+		Backspace().
+		ExpectText("hello\n\nworl").
+		Enter().
+		Submit()
+
+	expected := "type \"hello\" and press ENTER\npress ENTER\ntype \"world\"\npress BACKSPACE\nExpect text: \"hello\\n\\nworl\"\npress ENTER\npress ENTER twice"
+
+	assert.Equal(t, expected, p.answer.String())
+}
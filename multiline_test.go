@@ -1,7 +1,9 @@
 package surveyexpect_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/terminal"
@@ -36,6 +38,14 @@ func TestMultilinePrompt(t *testing.T) {
 					Answer("")
 			}),
 		},
+		{
+			scenario: "press keys",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiline("Enter your comment").
+					Press(surveyexpect.MustParseKeys("hello<ENTER>world<ENTER><ENTER><ENTER>")...)
+			}),
+			expectedAnswer: "hello\nworld",
+		},
 		{
 			scenario: "input is interrupted",
 			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
@@ -70,6 +80,20 @@ func TestMultilinePrompt(t *testing.T) {
 			},
 			expectedAnswer: "this is a multiline\ncomment\n\nend",
 		},
+		{
+			scenario: "answer is rejected by validators",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiline("Enter your comment").
+					Answer("").ExpectValidationError("Value is required").
+					Answer("hi").ExpectValidationError("value is too short. Min length is 5").
+					Answer("this is a multiline\ncomment")
+			}),
+			options: []survey.AskOpt{
+				survey.WithValidator(survey.Required),
+				survey.WithValidator(survey.MinLength(5)),
+			},
+			expectedAnswer: "this is a multiline\ncomment",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -100,6 +124,162 @@ func TestMultilinePrompt(t *testing.T) {
 	}
 }
 
+func TestMultilinePrompt_AnswerSequence(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectMultiline("Enter a token:").
+			AnswerSequence("alpha", "beta")
+	})(t)
+
+	p := &survey.Multiline{Message: "Enter a token:"}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answers []string
+
+		for i := 0; i < 2; i++ {
+			var answer string
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+
+			answers = append(answers, answer)
+		}
+
+		assert.Equal(t, []string{"alpha", "beta"}, answers)
+	})
+}
+
+func TestMultilinePrompt_ExpectAnswered(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectMultiline("Enter a description:").
+			ExpectAnswered("HELLO\nWORLD").
+			Answer("hello\nworld")
+	})(t)
+
+	q := []*survey.Question{{
+		Name:      "description",
+		Prompt:    &survey.Multiline{Message: "Enter a description:"},
+		Transform: survey.TransformString(strings.ToUpper),
+	}}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		err := survey.Ask(q, &answer, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "HELLO\nWORLD", answer)
+	})
+}
+
+func TestMultilinePrompt_LineEditing(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario       string
+		expectSurvey   surveyexpect.Expector
+		expectedAnswer string
+	}{
+		{
+			scenario: "empty line in the middle",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiline("Enter your comment").
+					TypeLine("hello").
+					ExpectText("hello").
+					TypeLine("").
+					TypeLine("world").
+					ExpectText("hello\n\nworld").
+					Submit()
+			}),
+			expectedAnswer: "hello\n\nworld",
+		},
+		{
+			scenario: "edit a line",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiline("Enter your comment").
+					TypeLine("first").
+					Type("helo world").
+					MoveLeft(7).
+					Type("l").
+					ExpectText("first\nhello world").
+					Home().
+					ForwardDelete().
+					Type("H").
+					End().
+					Backspace(5).
+					Type("there").
+					MoveLeft(5).
+					MoveRight(5).
+					ExpectText("first\nHello there").
+					Enter().
+					Submit()
+			}),
+			expectedAnswer: "first\nHello there",
+		},
+		{
+			scenario: "start with typing",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiline("Enter your comment").
+					Type("world").
+					Press(surveyexpect.KeyCtrlA).
+					Type("hello ").
+					Enter().
+					Submit()
+			}),
+			expectedAnswer: "hello world",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			s := tc.expectSurvey(t)
+			p := &survey.Multiline{Message: "Enter your comment"}
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				var answer string
+				err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedAnswer, answer)
+			})
+		})
+	}
+}
+
+func TestMultilinePrompt_UnexpectedText(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		s.ExpectMultiline("Enter your comment").
+			TypeLine("hello").
+			Type("wrld").
+			ExpectText("hello\nworld").
+			Enter().
+			Submit()
+	})(testingT)
+
+	p := &survey.Multiline{Message: "Enter your comment"}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.Contains(t, testingT.ErrorString(), `unexpected text: expected "hello\nworld", got "hello\nwrld"`)
+}
+
 func TestMultilinePrompt_SurveyInterrupted(t *testing.T) {
 	t.Parallel()
 
@@ -6,8 +6,11 @@ var _ Prompt = (*MultiSelectPrompt)(nil)
 type MultiSelectPrompt struct {
 	*basePrompt
 
-	message string
-	steps   *InlineSteps
+	defaultValue []string
+	texts        *optionTexts
+	steps        *InlineSteps
+	vimMode      bool
+	interrupted  bool
 }
 
 func (p *MultiSelectPrompt) append(steps ...Step) *MultiSelectPrompt {
@@ -32,7 +35,18 @@ func (p *MultiSelectPrompt) ShowHelp(help string, options ...string) *MultiSelec
 //	Survey.ExpectMultiSelect("Select a language:").
 //		Type("Eng")
 func (p *MultiSelectPrompt) Type(s string) *MultiSelectPrompt {
-	return p.append(typeAnswer(s))
+	p.lock()
+	defer p.unlock()
+
+	// Survey leaves vim mode when the filter is typed, but "j" and "k" would move the cursor instead.
+	if p.vimMode {
+		p.steps.Append(toggleVimMode())
+		p.vimMode = false
+	}
+
+	p.steps.Append(typeAnswer(s))
+
+	return p
 }
 
 // Tab sends the TAB key the indicated times. Default is 1 when omitted.
@@ -44,6 +58,15 @@ func (p *MultiSelectPrompt) Tab(times ...int) *MultiSelectPrompt {
 	return p.append(repeatStep(pressTab(), times...)...)
 }
 
+// Press sends the keys, see ParseKeys for the notation.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Press(surveyexpect.KeyDown, surveyexpect.KeyDown).
+//			Press(surveyexpect.MustParseKeys("Eng<TAB>")...)
+func (p *MultiSelectPrompt) Press(keys ...Key) *MultiSelectPrompt {
+	return p.append(pressKeys(keys...))
+}
+
 // Interrupt sends ^C and ends the sequence.
 //
 //	   Survey.ExpectMultiSelect("Select a language:").
@@ -51,6 +74,11 @@ func (p *MultiSelectPrompt) Tab(times ...int) *MultiSelectPrompt {
 func (p *MultiSelectPrompt) Interrupt() {
 	p.append(pressInterrupt())
 	p.steps.Close()
+
+	p.lock()
+	defer p.unlock()
+
+	p.interrupted = true
 }
 
 // Enter sends the ENTER key and ends the sequence.
@@ -58,9 +86,32 @@ func (p *MultiSelectPrompt) Interrupt() {
 //	   Survey.ExpectMultiSelect("Select a language:").
 //	   	Type("Eng").
 //			Enter()
-func (p *MultiSelectPrompt) Enter() {
+func (p *MultiSelectPrompt) Enter() *MultiSelectSubmission {
 	p.append(pressEnter())
 	p.steps.Close()
+
+	return &MultiSelectSubmission{parent: p}
+}
+
+// AnswerSequence checks a different selection of options and sends the ENTER key each time the prompt is asked, the
+// first selection for the first time, the second one for the second time and so on. The prompt is expected to be asked
+// as many times as the selections. It ends the sequence, the steps before it are done every time.
+//
+//	   Survey.ExpectMultiSelect("Select the languages:").
+//			AnswerSequence([]string{"Go", "Rust"}, []string{"Python"})
+func (p *MultiSelectPrompt) AnswerSequence(selections ...[]string) {
+	answers := make([]Step, 0, len(selections))
+
+	for _, options := range selections {
+		answers = append(answers, submitOptions(p.texts, options...))
+	}
+
+	p.lock()
+	a := sequenceAnswers(p.basePrompt, answers...)
+	p.unlock()
+
+	p.append(a)
+	p.steps.Close()
 }
 
 // Delete sends the DELETE key the indicated times. Default is 1 when omitted.
@@ -72,22 +123,77 @@ func (p *MultiSelectPrompt) Delete(times ...int) *MultiSelectPrompt {
 	return p.append(repeatStep(pressDelete(), times...)...)
 }
 
-// MoveUp sends the ARROW UP key the indicated times. Default is 1 when omitted.
+// MoveUp sends the ARROW UP key, or "k" in vim mode, the indicated times. Default is 1 when omitted.
 //
 //	   Survey.ExpectMultiSelect("Select a language:").
 //	   	Type("Eng").
 //			MoveUp()
 func (p *MultiSelectPrompt) MoveUp(times ...int) *MultiSelectPrompt {
-	return p.append(repeatStep(pressArrowUp(), times...)...)
+	return p.append(repeatStep(p.moveKey(pressVimUp(), pressArrowUp()), times...)...)
 }
 
-// MoveDown sends the ARROW DOWN key the indicated times. Default is 1 when omitted.
+// MoveDown sends the ARROW DOWN key, or "j" in vim mode, the indicated times. Default is 1 when omitted.
 //
 //	   Survey.ExpectMultiSelect("Select a language:").
 //	   	Type("Eng").
 //			MoveDown()
 func (p *MultiSelectPrompt) MoveDown(times ...int) *MultiSelectPrompt {
-	return p.append(repeatStep(pressArrowDown(), times...)...)
+	return p.append(repeatStep(p.moveKey(pressVimDown(), pressArrowDown()), times...)...)
+}
+
+// VimMode indicates that the prompt is in vim mode, like survey.MultiSelect{VimMode: true}. MoveUp and MoveDown send
+// "k" and "j", and Type leaves vim mode before typing the filter.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	VimMode().
+//			MoveDown()
+func (p *MultiSelectPrompt) VimMode() *MultiSelectPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.vimMode = true
+
+	return p
+}
+
+// ToggleVimMode sends the ESC key to enter or leave vim mode.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Type("Eng").
+//	   	ToggleVimMode().
+//			MoveDown()
+func (p *MultiSelectPrompt) ToggleVimMode() *MultiSelectPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.steps.Append(toggleVimMode())
+	p.vimMode = !p.vimMode
+
+	return p
+}
+
+// ProbeVimMode finds out whether vim mode is enabled or disabled, and expects it. Survey does not show the mode, so "j"
+// is sent to see whether it moves the cursor or goes to the filter. The key is then taken back with "k" or BACKSPACE.
+// Changing the filter may move the cursor, so the option that was highlighted is highlighted again, and the list is
+// expected to be rendered as before. The probe fails when the list has no option, because survey leaves vim mode when
+// "j" goes to the filter.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	ProbeVimMode(true).
+//			MoveDown()
+func (p *MultiSelectPrompt) ProbeVimMode(enabled bool) *MultiSelectPrompt {
+	return p.append(probeVimMode(p.text, enabled, true))
+}
+
+func (p *MultiSelectPrompt) moveKey(vim, arrow *Action) *Action {
+	p.lock()
+	defer p.unlock()
+
+	if p.vimMode {
+		return vim
+	}
+
+	return arrow
 }
 
 // Select selects an option. If the option is selected, it will be deselected.
@@ -99,6 +205,27 @@ func (p *MultiSelectPrompt) Select() *MultiSelectPrompt {
 	return p.append(pressSpace())
 }
 
+// Check moves the cursor to the options and checks the ones that are not checked yet. The list is scrolled when an
+// option is not visible. Note that survey clears the filter after an option is toggled.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Check("English", "French").
+//			Enter()
+func (p *MultiSelectPrompt) Check(options ...string) *MultiSelectPrompt {
+	return p.append(toggleOptions(p.texts, true, options...))
+}
+
+// Uncheck moves the cursor to the options and unchecks the ones that are checked. The list is scrolled when an option
+// is not visible. Note that survey clears the filter after an option is toggled.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	SelectAll().
+//	   	Uncheck("English").
+//			Enter()
+func (p *MultiSelectPrompt) Uncheck(options ...string) *MultiSelectPrompt {
+	return p.append(toggleOptions(p.texts, false, options...))
+}
+
 // SelectNone deselects all filtered options.
 //
 //	   Survey.ExpectMultiSelect("Select a language:").
@@ -126,29 +253,326 @@ func (p *MultiSelectPrompt) ExpectOptions(options ...string) *MultiSelectPrompt
 	return p.append(expectMultiSelect(options...))
 }
 
+// WithDescriptions declares the descriptions that survey renders next to the options, by their labels, without
+// expecting the options. Survey renders an option with a description as "label - description", so the steps only find
+// an option by its label once its description is declared here or with ExpectOptionsWithDescription.
+//
+//	   Survey.ExpectMultiSelect("Select a plan:").
+//	   	WithDescriptions(map[string]string{"Free": "$0/month", "Pro": "$10/month"}).
+//			Check("Pro")
+func (p *MultiSelectPrompt) WithDescriptions(descriptions map[string]string) *MultiSelectPrompt {
+	p.texts.describe(descriptions)
+
+	return p
+}
+
+// ExpectOptionsWithDescription expects exactly the visible options with their descriptions, in order, and declares
+// the descriptions like WithDescriptions.
+//
+//	   Survey.ExpectMultiSelect("Select regions:").
+//			ExpectOptionsWithDescription(
+//				surveyexpect.OptionWithDescription{Option: "eu-west-1", Description: "Ireland", Highlighted: true},
+//				surveyexpect.OptionWithDescription{Option: "us-east-1", Description: "N. Virginia"},
+//			)
+func (p *MultiSelectPrompt) ExpectOptionsWithDescription(options ...OptionWithDescription) *MultiSelectPrompt {
+	return p.append(expectOptionsWithDescription(true, p.texts, options...))
+}
+
+// ExpectExactOptions expects exactly the list of visible options, in order. The highlighted option is prefixed with
+// "> ".
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Type("Eng").
+//			ExpectExactOptions("> [ ]  English")
+func (p *MultiSelectPrompt) ExpectExactOptions(options ...string) *MultiSelectPrompt {
+	return p.append(expectExactOptions(true, options...))
+}
+
+// ExpectWindow expects the visible options to be the given number of options from the index start, starting from 0,
+// in the options of the prompt or in the options that match the filter when there is one. Survey does not show the
+// index, so the cursor may be moved up to the first page and back, which shows the same options again.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	ScrollTo("Vietnamese").
+//			ExpectWindow(5, 7)
+func (p *MultiSelectPrompt) ExpectWindow(start, size int) *MultiSelectPrompt {
+	return p.append(expectWindow(true, start, size))
+}
+
+// ExpectVisibleOptions expects exactly the visible options, in order, regardless of the highlighted option.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	ScrollTo("Vietnamese").
+//			ExpectVisibleOptions("Spanish", "Thai", "Vietnamese")
+func (p *MultiSelectPrompt) ExpectVisibleOptions(options ...string) *MultiSelectPrompt {
+	return p.append(expectVisibleOptions(true, p.texts, options...))
+}
+
+// ScrollTo scrolls the list until the option is visible, even if it is above the visible page. The cursor moves while
+// scrolling, but the option is not necessarily highlighted.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	ScrollTo("Vietnamese").
+//			ExpectVisibleOptions("Spanish", "Thai", "Vietnamese")
+func (p *MultiSelectPrompt) ScrollTo(option string) *MultiSelectPrompt {
+	return p.append(scrollTo(true, p.texts, option))
+}
+
+// ExpectHighlighted expects the option to be highlighted.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	MoveDown().
+//			ExpectHighlighted("English")
+func (p *MultiSelectPrompt) ExpectHighlighted(option string) *MultiSelectPrompt {
+	return p.append(expectHighlightedOption(true, p.texts, option))
+}
+
+// ExpectChecked expects exactly the options to be checked among the visible ones, regardless of the order.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Check("English", "French").
+//			ExpectChecked("French", "English")
+func (p *MultiSelectPrompt) ExpectChecked(options ...string) *MultiSelectPrompt {
+	return p.append(expectChecked(p.texts, options...))
+}
+
+// ExpectFilter expects the text that filters the options.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Type("Eng").
+//			ExpectFilter("Eng")
+func (p *MultiSelectPrompt) ExpectFilter(filter string) *MultiSelectPrompt {
+	return p.append(expectFilter(p.text, filter))
+}
+
+// ExpectDefault expects the visible options to be checked when the prompt is shown. Without any options, nothing is
+// expected to be checked. The check consumes the first rendering of the list, so
+// ExpectOptions that follows applies to the list rendered after the next action.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	ExpectDefault("English", "French").
+//			Enter()
+func (p *MultiSelectPrompt) ExpectDefault(options ...string) *MultiSelectPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.defaultValue = append(make([]string, 0, len(options)), options...)
+
+	return p
+}
+
+// ExpectAnswered expects the options that survey renders next to the message once the prompt is answered, they are
+// separated by commas.
+//
+//	   Survey.ExpectMultiSelect("Select languages:").
+//	   	ExpectAnswered("English, French").
+//			Check("English", "French").
+//			Enter()
+func (p *MultiSelectPrompt) ExpectAnswered(answer string) *MultiSelectPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.expectAnsweredLocked(answer)
+
+	return p
+}
+
 // Do runs the step.
 func (p *MultiSelectPrompt) Do(c Console) error {
-	if _, err := c.ExpectString(p.message); err != nil {
+	if err := p.expectMessage(c); err != nil {
+		return err
+	}
+
+	if err := p.expectDefault(c); err != nil {
+		return err
+	}
+
+	// The steps are consumed while they are done, keep a copy for the next time the prompt is asked.
+	p.lock()
+	next := p.steps.clone()
+	p.unlock()
+
+	if err := p.steps.Do(c); err != nil {
 		return err
 	}
 
-	return p.steps.Do(c)
+	// The prompt is answered when the sequence ends, unless it is interrupted.
+	p.lock()
+	answered := p.steps.isClosed() && !p.interrupted
+	p.unlock()
+
+	if answered {
+		if err := p.readAnswer(c, false); err != nil {
+			return err
+		}
+	}
+
+	return p.repeat(next)
+}
+
+// repeat counts the call and sets the steps to be done again if the prompt is expected to be asked again.
+func (p *MultiSelectPrompt) repeat(next *InlineSteps) error {
+	p.lock()
+	defer p.unlock()
+
+	p.calledLocked()
+
+	// Times are discarded due to the interruption.
+	if p.interrupted {
+		return nil
+	}
+
+	err := p.isDoneLocked(nil)
+	if err != nil {
+		p.steps = next
+	}
+
+	return err
+}
+
+func (p *MultiSelectPrompt) expectDefault(c Console) error {
+	if p.defaultValue == nil {
+		return nil
+	}
+
+	rendered, err := readRenderedPrompt(c)
+	if err != nil {
+		return err
+	}
+
+	actual := checkedOptions(parseRenderedOptions(rendered, true))
+
+	if !p.texts.matchLabels(actual, p.defaultValue, false) {
+		return unexpectedDefault(p.defaultValue, actual)
+	}
+
+	return nil
 }
 
 // String represents the expectation as a string.
 func (p *MultiSelectPrompt) String() string {
 	var sb stringsBuilder
 
-	return sb.WriteLabelLinef("Expect", "MultiSelect Prompt").
-		WriteLabelLinef("Message", "%q", p.message).
-		WriteString(p.steps.String()).
-		String()
+	sb.WriteLabelLinef("Expect", "MultiSelect Prompt").
+		WriteLabelLinef("Message", "%s", p.message)
+
+	if p.question != "" {
+		sb.WriteLabelLinef("Question", "%q", p.question)
+	}
+
+	if p.defaultValue != nil {
+		sb.WriteLabelLinef("Default", "%q", p.defaultValue)
+	}
+
+	if p.answered != nil {
+		sb.WriteLabelLinef("Answered", "%q", *p.answered)
+	}
+
+	sb.WriteString(p.steps.String())
+
+	if calls := p.callsString(); calls != "" {
+		sb.WriteRune('\n').
+			WriteString(calls)
+	}
+
+	return sb.String()
+}
+
+// Once indicates that the message should only be asked once.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Once().
+//			Enter()
+func (p *MultiSelectPrompt) Once() *MultiSelectPrompt {
+	return p.Times(1)
+}
+
+// Twice indicates that the message should only be asked twice.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Twice().
+//			Enter()
+func (p *MultiSelectPrompt) Twice() *MultiSelectPrompt {
+	return p.Times(2)
+}
+
+// Times indicates that the message should only be asked the indicated number of times. The sequence is done every
+// time the prompt is asked.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Times(5).
+//			Enter()
+func (p *MultiSelectPrompt) Times(i int) *MultiSelectPrompt {
+	p.times(i)
+
+	return p
+}
+
+// Maybe indicates that the message may be asked once, or not at all.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Maybe().
+//			Enter()
+func (p *MultiSelectPrompt) Maybe() *MultiSelectPrompt {
+	return p.AtMost(1)
+}
+
+// AtLeast indicates that the message should be asked at least the indicated number of times.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	AtLeast(2).
+//			Enter()
+func (p *MultiSelectPrompt) AtLeast(i int) *MultiSelectPrompt {
+	p.cardinality(i, anyTimes)
+
+	return p
+}
+
+// AtMost indicates that the message may be asked up to the indicated number of times, or not at all.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	AtMost(3).
+//			Enter()
+func (p *MultiSelectPrompt) AtMost(i int) *MultiSelectPrompt {
+	p.cardinality(0, i)
+
+	return p
 }
 
-func newMultiSelect(parent *Survey, message string) *MultiSelectPrompt {
+// AnyTimes indicates that the message may be asked any number of times, or not at all.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	AnyTimes().
+//			Enter()
+func (p *MultiSelectPrompt) AnyTimes() *MultiSelectPrompt {
+	p.cardinality(0, anyTimes)
+
+	return p
+}
+
+func newMultiSelect(parent *Survey, message Matcher) *MultiSelectPrompt {
 	return &MultiSelectPrompt{
-		basePrompt: &basePrompt{parent: parent},
-		message:    message,
+		basePrompt: &basePrompt{parent: parent, message: message},
+		texts:      newOptionTexts(),
 		steps:      inlineSteps(),
 	}
 }
+
+// MultiSelectSubmission is the submission of the selected options.
+type MultiSelectSubmission struct {
+	parent *MultiSelectPrompt
+}
+
+// ExpectValidationError expects the selected options to be rejected by a validator, so the prompt is asked again and
+// the sequence continues.
+//
+//	   Survey.ExpectMultiSelect("Select a language:").
+//	   	Enter().
+//	   	ExpectValidationError("Value is required").
+//	   	Select().
+//			Enter()
+func (s *MultiSelectSubmission) ExpectValidationError(err string) *MultiSelectPrompt {
+	s.parent.steps.reopen()
+
+	return s.parent.append(expectValidationError(err))
+}
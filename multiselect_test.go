@@ -18,6 +18,7 @@ func TestMultiSelectPrompt(t *testing.T) {
 	testCases := []struct {
 		scenario       string
 		expectSurvey   surveyexpect.Expector
+		defaultValue   interface{}
 		help           string
 		showHelp       bool
 		options        []string
@@ -31,6 +32,93 @@ func TestMultiSelectPrompt(t *testing.T) {
 					Enter()
 			}),
 		},
+		{
+			scenario: "press keys",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiSelect("Select destinations").
+					Press(surveyexpect.MustParseKeys("<DOWN> <DOWN><DOWN> <ENTER>")...)
+			}),
+			expectedAnswer: []string{"Germany", "Singapore"},
+		},
+		{
+			scenario: "enter with defaults",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiSelect("Select destinations").
+					ExpectDefault("Germany", "Malaysia").
+					Enter()
+			}),
+			defaultValue:   []string{"Germany", "Malaysia"},
+			expectedAnswer: []string{"Germany", "Malaysia"},
+		},
+		{
+			scenario: "enter without defaults",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiSelect("Select destinations").
+					ExpectDefault().
+					Select().
+					Enter()
+			}),
+			expectedAnswer: []string{"France"},
+		},
+		{
+			scenario: "check by label",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiSelect("Select destinations").
+					Check("Germany", "Vietnam", "Malaysia").
+					Enter()
+			}),
+			expectedAnswer: []string{"Germany", "Malaysia", "Vietnam"},
+		},
+		{
+			scenario: "check options that are already checked",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiSelect("Select destinations").
+					Check("Germany", "Thailand").
+					Enter()
+			}),
+			defaultValue:   []string{"Germany"},
+			expectedAnswer: []string{"Germany", "Thailand"},
+		},
+		{
+			scenario: "check after filtering",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiSelect("Select destinations").
+					Type("United").
+					Check("United States").
+					Enter()
+			}),
+			expectedAnswer: []string{"United States"},
+		},
+		{
+			scenario: "uncheck by label",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiSelect("Select destinations").
+					SelectAll().
+					Uncheck("France", "Vietnam", "Thailand").
+					Enter()
+			}),
+			expectedAnswer: []string{"Germany", "Malaysia", "Singapore", "United Kingdom", "United States"},
+		},
+		{
+			scenario: "expect the exact list",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectMultiSelect("Select destinations").
+					Type("United").
+					ExpectFilter("United").
+					ExpectExactOptions(
+						"> [ ]  United Kingdom",
+						"[ ]  United States",
+					).
+					Check("United States").
+					ExpectFilter("").
+					ExpectChecked("United States").
+					Check("France", "Germany").
+					ExpectChecked("Germany", "France", "United States").
+					ExpectHighlighted("Germany").
+					Enter()
+			}),
+			expectedAnswer: []string{"France", "Germany", "United States"},
+		},
 		{
 			scenario: "with help and ask for it",
 			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
@@ -127,6 +215,7 @@ func TestMultiSelectPrompt(t *testing.T) {
 			p := &survey.MultiSelectTemplateData{
 				MultiSelect: survey.MultiSelect{
 					Message: "Select destinations",
+					Default: tc.defaultValue,
 					Help:    tc.help,
 					Options: []string{
 						"France",
@@ -159,6 +248,277 @@ func TestMultiSelectPrompt(t *testing.T) {
 	}
 }
 
+func TestMultiSelectPrompt_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectMultiSelect("Select destinations").
+			Enter().
+			ExpectValidationError("Value is required").
+			Select().
+			Enter().
+			ExpectValidationError("value is too short. Min items is 2").
+			Select().
+			MoveDown().
+			Select().
+			Enter()
+	})(t)
+
+	p := &survey.MultiSelect{
+		Message: "Select destinations",
+		Options: []string{"France", "Germany", "Vietnam"},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer []string
+		err := survey.AskOne(p, &answer,
+			options.WithStdio(stdio),
+			survey.WithValidator(survey.Required),
+			survey.WithValidator(survey.MinItems(2)),
+		)
+
+		assert.Equal(t, []string{"France", "Germany"}, answer)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMultiSelectPrompt_UnexpectedDefault(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(100 * time.Millisecond)
+
+		s.ExpectMultiSelect("Select destinations").
+			ExpectDefault("France", "Vietnam").
+			Enter()
+	})(testingT)
+
+	p := &survey.MultiSelect{
+		Message: "Select destinations",
+		Options: []string{"France", "Germany", "Vietnam"},
+		Default: []string{"Vietnam"},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer []string
+		_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	expectedError := `unexpected default value: expected ["France" "Vietnam"], got ["Vietnam"]`
+
+	assert.Contains(t, testingT.ErrorString(), expectedError)
+}
+
+func TestMultiSelectPrompt_CheckNotFound(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		s.ExpectMultiSelect("Select destinations").
+			Check("Germany", "Japan").
+			Enter()
+	})(testingT)
+
+	p := &survey.MultiSelect{
+		Message: "Select destinations",
+		Options: []string{"France", "Germany", "Vietnam"},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer []string
+		_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	expectedError := "option not found: \"Japan\", visible options:\n  [ ]  France\n> [x]  Germany\n  [ ]  Vietnam"
+
+	assert.Contains(t, testingT.ErrorString(), expectedError)
+}
+
+func TestMultiSelectPrompt_Pagination(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectMultiSelect("Select regions").
+			ExpectWindow(0, 5).
+			ScrollTo("Region 20").
+			ExpectWindow(15, 5).
+			ExpectVisibleOptions("Region 16", "Region 17", "Region 18", "Region 19", "Region 20").
+			Check("Region 17", "Region 03").
+			Enter()
+	})(t)
+
+	p := &survey.MultiSelect{
+		Message: "Select regions",
+		Options: regions(20),
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer []string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio), survey.WithPageSize(5))
+
+		assert.Equal(t, []string{"Region 03", "Region 17"}, answer)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMultiSelectPrompt_VimMode(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectMultiSelect("Select countries").
+			VimMode().
+			ProbeVimMode(true).
+			MoveDown().
+			Select().
+			Type("ma").
+			ProbeVimMode(false).
+			Select().
+			ExpectChecked("Germany", "Malaysia").
+			Enter()
+	})(t)
+
+	p := &survey.MultiSelect{
+		Message: "Select countries",
+		Options: []string{"France", "Germany", "Japan", "Malaysia"},
+		VimMode: true,
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer []string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.Equal(t, []string{"Germany", "Malaysia"}, answer)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMultiSelectPrompt_AnswerSequence(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectMultiSelect("Select the languages").
+			AnswerSequence([]string{"Go", "Rust"}, []string{"Python"}, nil)
+	})(t)
+
+	p := &survey.MultiSelect{
+		Message: "Select the languages",
+		Options: []string{"Go", "Python", "Rust"},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answers [][]string
+
+		for i := 0; i < 3; i++ {
+			var answer []string
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+
+			answers = append(answers, answer)
+		}
+
+		assert.Equal(t, [][]string{{"Go", "Rust"}, {"Python"}, nil}, answers)
+	})
+}
+
+func TestMultiSelectPrompt_ExpectAnswered(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectMultiSelect("Select the languages").
+			ExpectAnswered("Go, Rust").
+			Check("Go", "Rust").
+			Enter()
+	})(t)
+
+	p := &survey.MultiSelect{
+		Message: "Select the languages",
+		Options: []string{"Go", "Python", "Rust"},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer []string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Go", "Rust"}, answer)
+	})
+}
+
+func TestMultiSelectPrompt_Description(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectMultiSelect("Select regions").
+			ExpectOptionsWithDescription(
+				surveyexpect.OptionWithDescription{Option: "eu-west-1", Description: "Ireland", Highlighted: true},
+				surveyexpect.OptionWithDescription{Option: "us-east-1", Description: "N. Virginia"},
+			).
+			Check("us-east-1").
+			ExpectChecked("us-east-1").
+			Enter()
+	})(t)
+
+	p := &survey.MultiSelect{
+		Message: "Select regions",
+		Options: []string{"eu-west-1", "us-east-1"},
+		Description: func(value string, _ int) string {
+			return map[string]string{
+				"eu-west-1": "Ireland",
+				"us-east-1": "N. Virginia",
+			}[value]
+		},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer []string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.Equal(t, []string{"us-east-1"}, answer)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMultiSelectPrompt_UnexpectedChecked(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		s.ExpectMultiSelect("Select destinations").
+			Check("Germany").
+			ExpectChecked("France").
+			Enter()
+	})(testingT)
+
+	p := &survey.MultiSelect{
+		Message: "Select destinations",
+		Options: []string{"France", "Germany", "Vietnam"},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer []string
+		_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	expectedError := "unexpected checked state: expected [\"France\"] to be checked, got:\n  [ ]  France\n> [x]  Germany\n  [ ]  Vietnam"
+
+	assert.Contains(t, testingT.ErrorString(), expectedError)
+}
+
 func TestMultiSelectPrompt_NoHelpButStillExpect(t *testing.T) {
 	t.Parallel()
 
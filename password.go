@@ -2,8 +2,12 @@ package surveyexpect
 
 import (
 	"strings"
+	"unicode/utf8"
 )
 
+// defaultHideCharacter is the character that survey shows for each character of a password by default.
+const defaultHideCharacter = '*'
+
 var (
 	_ Prompt = (*PasswordPrompt)(nil)
 	_ Answer = (*PasswordAnswer)(nil)
@@ -13,20 +17,73 @@ var (
 type PasswordPrompt struct {
 	*basePrompt
 
-	message string
-	answer  Answer
+	hideCharacter rune
+	hidden        bool
+	notEchoed     bool
+	answer        Answer
 }
 
-// ShowHelp sets help for the expectation.
+// WithHideCharacter sets the character that the prompt shows for each character of the password, when survey is asked
+// with survey.WithHideCharacter. Default is *.
 //
 //	Survey.ExpectPassword("Enter password:").
-//		ShowHelp("Your shiny password")
-func (p *PasswordPrompt) ShowHelp(help string, options ...string) {
+//		WithHideCharacter('•').
+//		Answer("secret")
+func (p *PasswordPrompt) WithHideCharacter(char rune) *PasswordPrompt {
 	p.lock()
 	defer p.unlock()
 
-	p.answer = helpAnswer(help, options...)
-	p.timesLocked(1)
+	p.hideCharacter = char
+
+	return p
+}
+
+// ExpectHidden expects the answers never to show up in plain text anywhere in the output that the survey writes, even
+// if they are erased or redrawn later. The keys that are sent to the prompt are not part of the output, but the message
+// and the help are, so use ExpectNotEchoed when they may contain the answer. The output is checked when the survey is
+// done.
+//
+//	Survey.ExpectPassword("Enter password:").
+//		ExpectHidden().
+//		Answer("secret")
+func (p *PasswordPrompt) ExpectHidden() *PasswordPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.hidden = true
+
+	return p
+}
+
+// ExpectNotEchoed expects the answers never to show up in plain text on the screen where they are echoed, after the
+// message of the prompt. Unlike ExpectHidden, the message, the help and the text that is erased from the screen are not
+// checked. The screen is checked when the survey is done.
+//
+//	Survey.ExpectPassword("Enter your password:").
+//		ExpectNotEchoed().
+//		Answer("password")
+func (p *PasswordPrompt) ExpectNotEchoed() *PasswordPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.notEchoed = true
+
+	return p
+}
+
+// ShowHelp asks for help and asserts the help text. The prompt is asked again after showing the help, so the next
+// answer is given to the same prompt.
+//
+//	Survey.ExpectPassword("Enter password:").
+//		ShowHelp("Your shiny password").
+//		Answer("secret")
+func (p *PasswordPrompt) ShowHelp(help string, options ...string) *PasswordPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.answer = retryAnswer(p.answer, helpAnswer(help, options...), waitForCursorTwice)
+
+	return p
 }
 
 // Interrupt marks the answer is interrupted.
@@ -37,42 +94,141 @@ func (p *PasswordPrompt) Interrupt() {
 	p.lock()
 	defer p.unlock()
 
-	p.answer = interruptAnswer()
+	p.answer = retryAnswer(p.answer, interruptAnswer(), waitForCursorTwice)
 	p.timesLocked(1)
 }
 
-// Answer sets the answer to the password prompt.
+// Answer sets the answer to the password prompt. If the previous answer expects a validation error, the answer is
+// given when the prompt is asked again.
 //
 //	Survey.ExpectPassword("Enter password:").
 //		Answer("hello world!")
+//
+//	Survey.ExpectPassword("Enter password:").
+//		Answer("123").ExpectValidationError("value is too short. Min length is 8").
+//		Answer("hello world!")
 func (p *PasswordPrompt) Answer(answer string) *PasswordAnswer {
 	p.lock()
 	defer p.unlock()
 
 	a := newPasswordAnswer(p, answer)
-	p.answer = a
+	p.answer = retryAnswer(p.answer, a, waitForCursorTwice)
 
 	return a
 }
 
+// AnswerFunc computes the answer from the screen when the prompt is asked.
+//
+//	Survey.ExpectPassword("Enter the one-time code:").
+//		Capture(`Your code is (?P<code>\d+)`, nil).
+//		AnswerFunc(func(_ surveyexpect.Screen, captures map[string]string) string {
+//			return captures["code"]
+//		})
+func (p *PasswordPrompt) AnswerFunc(fn AnswerFunc) *PasswordAnswer {
+	p.lock()
+	defer p.unlock()
+
+	a := newPasswordAnswerFunc(p, fn)
+	p.answer = retryAnswer(p.answer, a, waitForCursorTwice)
+
+	return a
+}
+
+// Capture captures a value from the screen when the prompt is asked. The first group of the regular expression, or
+// the whole match if there is no group, is stored in dst unless it is nil, and the named groups are passed to
+// AnswerFunc.
+//
+//	Survey.ExpectPassword("Enter the one-time code:").
+//		Capture(`Your code is (\d+)`, &code).
+//		Answer("123456")
+func (p *PasswordPrompt) Capture(pattern string, dst *string) *PasswordPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.captureLocked(pattern, dst)
+
+	return p
+}
+
+// AnswerSequence gives a different answer each time the prompt is asked, the first answer for the first time, the
+// second one for the second time and so on. The prompt is expected to be asked as many times as the answers.
+//
+//	Survey.ExpectPassword("Enter the password:").
+//		AnswerSequence("alpha", "beta", "gamma")
+func (p *PasswordPrompt) AnswerSequence(answers ...string) *PasswordPrompt {
+	p.lock()
+	defer p.unlock()
+
+	steps := make([]Step, 0, len(answers))
+
+	for _, answer := range answers {
+		steps = append(steps, newPasswordAnswer(p, answer))
+	}
+
+	p.answer = retryAnswer(p.answer, sequenceAnswers(p.basePrompt, steps...), waitForCursorTwice)
+
+	return p
+}
+
+// Press answers the prompt by sending the keys, see ParseKeys for the notation. The keys are expected to end the
+// prompt, for example with <ENTER>.
+//
+//	Survey.ExpectPassword("Enter password:").
+//		Press(surveyexpect.MustParseKeys("secret<BACKSPACE>t<ENTER>")...)
+func (p *PasswordPrompt) Press(keys ...Key) *PasswordPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.answer = retryAnswer(p.answer, pressKeys(keys...), waitForCursorTwice)
+
+	return p
+}
+
+// ExpectAnswered expects the answer that is shown next to the message once the prompt is answered. Survey does not
+// render the answer again, so it is the masked answer as it is typed.
+//
+//	Survey.ExpectPassword("Enter password:").
+//		ExpectAnswered("******").
+//		Answer("secret")
+func (p *PasswordPrompt) ExpectAnswered(answer string) *PasswordPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.expectAnsweredLocked(answer)
+
+	return p
+}
+
 // Do runs the step.
 func (p *PasswordPrompt) Do(c Console) error {
-	if _, err := c.ExpectString(p.message); err != nil {
+	if err := p.expectMessage(c); err != nil {
 		return err
 	}
 
-	_ = waitForCursorTwice(c) //nolint: errcheck
+	// The prompt is ready for the answer once it asks for the cursor position.
+	if err := waitForCursorTwice(c); err != nil {
+		return err
+	}
+
+	if err := p.captureScreen(c); err != nil {
+		return err
+	}
 
 	err := p.answer.Do(c)
 	if err != nil && !IsInterrupted(err) {
 		return err
 	}
 
+	if err == nil && !expectsRetry(p.answer) {
+		if err := p.readAnswer(c, true); err != nil {
+			return err
+		}
+	}
+
 	p.lock()
 	defer p.unlock()
 
-	p.repeatability--
-	p.totalCalls++
+	p.calledLocked()
 
 	return p.isDoneLocked(err)
 }
@@ -82,16 +238,49 @@ func (p *PasswordPrompt) String() string {
 	var sb stringsBuilder
 
 	sb.WriteLabelLinef("Expect", "Password Prompt").
-		WriteLabelLinef("Message", "%q", p.message).
-		WriteLabelLinef("Answer", p.answer.String())
+		WriteLabelLinef("Message", "%s", p.message)
+
+	if p.question != "" {
+		sb.WriteLabelLinef("Question", "%q", p.question)
+	}
+
+	if p.answered != nil {
+		sb.WriteLabelLinef("Answered", "%q", *p.answered)
+	}
 
-	if p.repeatability > 0 && (p.totalCalls != 0 || p.repeatability != 1) {
-		sb.WriteLinef("(called: %d time(s), remaining: %d time(s))", p.totalCalls, p.repeatability)
+	sb.WriteLabelLinef("Answer", p.answer.String())
+
+	if calls := p.callsString(); calls != "" {
+		sb.WriteLinef("%s", calls)
 	}
 
 	return sb.String()
 }
 
+func (p *PasswordPrompt) mask(answer string) string {
+	p.lock()
+	defer p.unlock()
+
+	return strings.Repeat(string(p.hideCharacter), utf8.RuneCountInString(answer))
+}
+
+func (p *PasswordPrompt) expectHidden(answer string) {
+	p.lock()
+	sec := secret{
+		message:   p.message.String(),
+		text:      p.matched.Text,
+		answer:    answer,
+		hidden:    p.hidden,
+		notEchoed: p.notEchoed,
+	}
+	p.unlock()
+
+	// The prompt shares the lock with the survey.
+	if sec.hidden || sec.notEchoed {
+		p.parent.expectHidden(sec)
+	}
+}
+
 // Once indicates that the message should only be asked once.
 //
 //	Survey.ExpectPassword("Enter password:").
@@ -121,38 +310,89 @@ func (p *PasswordPrompt) Times(i int) *PasswordPrompt {
 	return p
 }
 
+// Maybe indicates that the message may be asked once, or not at all.
+//
+//	Survey.ExpectPassword("Enter password:").
+//		Answer("hello world!").
+//		Maybe()
+func (p *PasswordPrompt) Maybe() *PasswordPrompt {
+	return p.AtMost(1)
+}
+
+// AtLeast indicates that the message should be asked at least the indicated number of times.
+//
+//	Survey.ExpectPassword("Enter password:").
+//		Answer("hello world!").
+//		AtLeast(2)
+func (p *PasswordPrompt) AtLeast(i int) *PasswordPrompt {
+	p.cardinality(i, anyTimes)
+
+	return p
+}
+
+// AtMost indicates that the message may be asked up to the indicated number of times, or not at all.
+//
+//	Survey.ExpectPassword("Enter password:").
+//		Answer("hello world!").
+//		AtMost(3)
+func (p *PasswordPrompt) AtMost(i int) *PasswordPrompt {
+	p.cardinality(0, i)
+
+	return p
+}
+
+// AnyTimes indicates that the message may be asked any number of times, or not at all.
+//
+//	Survey.ExpectPassword("Enter password:").
+//		Answer("hello world!").
+//		AnyTimes()
+func (p *PasswordPrompt) AnyTimes() *PasswordPrompt {
+	p.cardinality(0, anyTimes)
+
+	return p
+}
+
 // PasswordAnswer is an answer for password question.
 type PasswordAnswer struct {
-	parent      *PasswordPrompt
-	answer      string
-	interrupted bool
+	parent          *PasswordPrompt
+	answer          string
+	fn              AnswerFunc
+	validationError string
+	interrupted     bool
 }
 
 // Do runs the step.
 // nolint: errcheck,gosec,nolintlint
 func (a *PasswordAnswer) Do(c Console) error {
+	answer, err := answerOf(c, a.parent.parent, a.answer, a.fn)
+	if err != nil {
+		return err
+	}
+
 	if a.interrupted {
-		c.Send(a.answer)
+		c.Send(answer)
 		c.ExpectEOF()
 
 		return nil
 	}
 
-	if a.answer == "" {
-		c.SendLine("")
-
-		return nil
-	}
+	if answer != "" {
+		a.parent.expectHidden(answer)
 
-	c.Send(a.answer)
+		c.Send(answer)
 
-	// Expect asterisks.
-	if _, err := c.ExpectString(strings.Repeat("*", len(a.answer))); err != nil {
-		return err
+		// Survey shows the hide character for each rune.
+		if _, err := c.ExpectString(a.parent.mask(answer)); err != nil {
+			return err
+		}
 	}
 
 	c.SendLine("")
 
+	if a.validationError != "" {
+		return expectValidationError(a.validationError).Do(c)
+	}
+
 	return nil
 }
 
@@ -162,26 +402,59 @@ func (a *PasswordAnswer) Interrupted() {
 	defer a.parent.unlock()
 
 	a.interrupted = true
+	a.validationError = ""
+}
+
+// ExpectValidationError expects the answer to be rejected by a validator, so the prompt is asked again.
+//
+//	Survey.ExpectPassword("Enter password:").
+//		Answer("123").ExpectValidationError("value is too short. Min length is 8").
+//		Answer("hello world!")
+func (a *PasswordAnswer) ExpectValidationError(err string) *PasswordPrompt {
+	a.parent.lock()
+	defer a.parent.unlock()
+
+	a.validationError = err
+	a.interrupted = false
+
+	return a.parent
+}
+
+func (a *PasswordAnswer) expectsRetry() bool {
+	return a.validationError != ""
 }
 
 // String represents the answer as a string.
 func (a *PasswordAnswer) String() string {
 	var sb stringsBuilder
 
-	sb.Writef("%q", a.answer)
+	if a.fn != nil {
+		sb.WriteString(computedAnswer)
+	} else {
+		sb.Writef("%q", a.answer)
+	}
 
 	if a.interrupted {
 		sb.WriteString(" and get interrupted")
+	} else if a.validationError != "" {
+		sb.Writef(" and get validation error %q", a.validationError)
 	}
 
 	return sb.String()
 }
 
-func newPassword(parent *Survey, message string) *PasswordPrompt {
+func newPassword(parent *Survey, message Matcher) *PasswordPrompt {
 	return &PasswordPrompt{
-		basePrompt: &basePrompt{parent: parent},
-		message:    message,
-		answer:     noAnswer(),
+		basePrompt:    &basePrompt{parent: parent, message: message},
+		hideCharacter: defaultHideCharacter,
+		answer:        noAnswer(),
+	}
+}
+
+func newPasswordAnswerFunc(parent *PasswordPrompt, fn AnswerFunc) *PasswordAnswer {
+	return &PasswordAnswer{
+		parent: parent,
+		fn:     fn,
 	}
 }
 
@@ -191,3 +464,20 @@ func newPasswordAnswer(parent *PasswordPrompt, answer string) *PasswordAnswer {
 		answer: answer,
 	}
 }
+
+// echoesAnswer checks whether the answer shows up on a line of the screen after the message, where the answer is
+// echoed. The message itself and the help are not checked.
+func echoesAnswer(s Screen, message, answer string) bool {
+	for _, l := range s.lines {
+		i := strings.Index(l, message)
+		if i < 0 {
+			continue
+		}
+
+		if strings.Contains(l[i+len(message):], answer) {
+			return true
+		}
+	}
+
+	return false
+}
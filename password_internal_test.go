@@ -9,7 +9,7 @@ import (
 func TestPasswordPrompt_Once(t *testing.T) {
 	t.Parallel()
 
-	p := newPassword(&Survey{}, "").Once()
+	p := newPassword(&Survey{}, Exact("")).Once()
 
 	assert.Equal(t, 1, p.repeatability)
 }
@@ -17,7 +17,7 @@ func TestPasswordPrompt_Once(t *testing.T) {
 func TestPasswordPrompt_Twice(t *testing.T) {
 	t.Parallel()
 
-	p := newPassword(&Survey{}, "").Twice()
+	p := newPassword(&Survey{}, Exact("")).Twice()
 
 	assert.Equal(t, 2, p.repeatability)
 }
@@ -25,7 +25,7 @@ func TestPasswordPrompt_Twice(t *testing.T) {
 func TestPasswordPrompt_Times(t *testing.T) {
 	t.Parallel()
 
-	p := newPassword(&Survey{}, "").Times(5)
+	p := newPassword(&Survey{}, Exact("")).Times(5)
 
 	assert.Equal(t, 5, p.repeatability)
 }
@@ -65,9 +65,9 @@ func TestPasswordPrompt_String(t *testing.T) {
 				basePrompt: &basePrompt{
 					repeatability: tc.repeatability,
 					totalCalls:    tc.totalCalls,
+					message:       Exact("Enter the password:"),
 				},
-				message: "Enter the password:",
-				answer:  noAnswer(),
+				answer: noAnswer(),
 			}
 
 			assert.Equal(t, tc.expected, p.String())
@@ -79,9 +79,10 @@ func TestPasswordAnswer_String(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		scenario    string
-		interrupted bool
-		expected    string
+		scenario        string
+		interrupted     bool
+		validationError string
+		expected        string
 	}{
 		{
 			scenario: "not interrupted",
@@ -92,6 +93,11 @@ func TestPasswordAnswer_String(t *testing.T) {
 			interrupted: true,
 			expected:    `"password" and get interrupted`,
 		},
+		{
+			scenario:        "validation error",
+			validationError: "Value is required",
+			expected:        `"password" and get validation error "Value is required"`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -100,11 +106,22 @@ func TestPasswordAnswer_String(t *testing.T) {
 			t.Parallel()
 
 			a := &PasswordAnswer{
-				answer:      "password",
-				interrupted: tc.interrupted,
+				answer:          "password",
+				interrupted:     tc.interrupted,
+				validationError: tc.validationError,
 			}
 
 			assert.Equal(t, tc.expected, a.String())
 		})
 	}
 }
+
+func TestPasswordPrompt_Mask(t *testing.T) {
+	t.Parallel()
+
+	p := newPassword(&Survey{}, Exact(""))
+
+	assert.Equal(t, "********", p.mask("pässwörd"))
+	assert.Equal(t, "**", p.mask("🔑🔑"))
+	assert.Equal(t, "••••••", p.WithHideCharacter('•').mask("secret"))
+}
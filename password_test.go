@@ -1,6 +1,7 @@
 package surveyexpect_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -49,6 +50,38 @@ func TestPasswordPrompt(t *testing.T) {
 			message:        "Enter a password:",
 			expectedAnswer: "secret",
 		},
+		{
+			scenario: "press keys",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter a password:").
+					Press(surveyexpect.MustParseKeys("secrex<BACKSPACE>t<ENTER>")...)
+			}),
+			message:        "Enter a password:",
+			expectedAnswer: "secret",
+		},
+		{
+			scenario: "password with non-ascii characters",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter a password:").
+					ExpectHidden().
+					Answer("pässwörd 🔑")
+			}),
+			message:        "Enter a password:",
+			expectedAnswer: "pässwörd 🔑",
+		},
+		{
+			scenario: "password with hide character",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter a password:").
+					WithHideCharacter('•').
+					Answer("secret")
+			}),
+			options: []survey.AskOpt{
+				survey.WithHideCharacter('•'),
+			},
+			message:        "Enter a password:",
+			expectedAnswer: "secret",
+		},
 		{
 			scenario: "password with visible help and do not ask for it",
 			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
@@ -97,6 +130,21 @@ func TestPasswordPrompt(t *testing.T) {
 			help:           "It is your secret",
 			expectedAnswer: "secret",
 		},
+		{
+			scenario: "ask for help, give an invalid answer and then answer",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter a password:").
+					ShowHelp("It is your secret").
+					Answer("123").ExpectValidationError("value is too short. Min length is 6").
+					Answer("secret")
+			}),
+			options: []survey.AskOpt{
+				survey.WithValidator(survey.MinLength(6)),
+			},
+			message:        "Enter a password:",
+			help:           "It is your secret",
+			expectedAnswer: "secret",
+		},
 		{
 			scenario: "input is interrupted",
 			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
@@ -134,6 +182,21 @@ func TestPasswordPrompt(t *testing.T) {
 			message:        "Enter a password:",
 			expectedAnswer: "secret",
 		},
+		{
+			scenario: "answer is rejected by validators",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter a password:").
+					Answer("").ExpectValidationError("Value is required").
+					Answer("123").ExpectValidationError("value is too short. Min length is 6").
+					Answer("secret")
+			}),
+			options: []survey.AskOpt{
+				survey.WithValidator(survey.Required),
+				survey.WithValidator(survey.MinLength(6)),
+			},
+			message:        "Enter a password:",
+			expectedAnswer: "secret",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -197,6 +260,209 @@ func TestPasswordPrompt_NoHelpButStillExpect(t *testing.T) {
 	t.Log(testingT.LogString())
 }
 
+func TestPasswordPrompt_PlaintextShown(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario     string
+		expectSurvey surveyexpect.Expector
+		before       string
+		after        string
+	}{
+		{
+			scenario: "echoed after the message",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter a password:").
+					ExpectHidden().
+					Answer("secret")
+			}),
+			after: "? Enter a password: secret\n",
+		},
+		{
+			scenario: "printed and erased",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter a password:").
+					ExpectHidden().
+					Answer("secret")
+			}),
+			after: "secret\r\x1b[K",
+		},
+		{
+			scenario: "printed before the message",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter a password:").
+					ExpectHidden().
+					Answer("secret")
+			}),
+			before: "Your last password was secret\n",
+		},
+		{
+			scenario: "echoed on the screen",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter a password:").
+					ExpectNotEchoed().
+					Answer("secret")
+			}),
+			after: "? Enter a password: secret\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			testingT := T()
+			s := tc.expectSurvey(testingT)
+			p := &survey.Password{Message: "Enter a password:"}
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				_, _ = fmt.Fprint(stdio.Out, tc.before)
+
+				var answer string
+				err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+				assert.NoError(t, err)
+
+				_, _ = fmt.Fprint(stdio.Out, tc.after)
+			})
+
+			assert.Contains(t, testingT.ErrorString(), `plaintext is shown: the answer to "Enter a password:"`)
+		})
+	}
+}
+
+func TestPasswordPrompt_NotEchoed(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario     string
+		expectSurvey surveyexpect.Expector
+		message      string
+		help         string
+	}{
+		{
+			scenario: "answer in message",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter password:").
+					ExpectNotEchoed().
+					Answer("word")
+			}),
+			message: "Enter password:",
+		},
+		{
+			scenario: "answer in help",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectPassword("Enter a password: [? for help]").
+					ShowHelp("It is a secret word")
+
+				s.ExpectPassword("Enter a password:").
+					ExpectNotEchoed().
+					Answer("secret")
+			}),
+			message: "Enter a password:",
+			help:    "It is a secret word",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			p := &survey.Password{Message: tc.message, Help: tc.help}
+
+			// Start the survey.
+			tc.expectSurvey(t).Start(func(stdio terminal.Stdio) {
+				var answer string
+				err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+				assert.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestPasswordPrompt_AnswerSequence(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectPassword("Enter a token:").
+			AnswerSequence("alpha", "beta")
+	})(t)
+
+	p := &survey.Password{Message: "Enter a token:"}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answers []string
+
+		for i := 0; i < 2; i++ {
+			var answer string
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+
+			answers = append(answers, answer)
+		}
+
+		assert.Equal(t, []string{"alpha", "beta"}, answers)
+	})
+}
+
+func TestPasswordPrompt_AnswerFunc(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectPassword("Enter the one-time code:").
+			Capture(`Your code is (?P<code>\d+)`, nil).
+			AnswerFunc(func(_ surveyexpect.Screen, captures map[string]string) string {
+				return captures["code"]
+			})
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+
+		_, _ = fmt.Fprintln(stdio.Out, "Your code is 654321") //nolint: errcheck
+
+		err := survey.AskOne(&survey.Password{Message: "Enter the one-time code:"}, &answer, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "654321", answer)
+	})
+}
+
+func TestPasswordPrompt_ExpectAnswered(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectPassword("Enter password:").
+			ExpectAnswered("******").
+			Answer("secret")
+
+		s.ExpectInput("Enter your name:").
+			Answer("john")
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var password, name string
+
+		err := survey.AskOne(&survey.Password{Message: "Enter password:"}, &password, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", password)
+
+		err = survey.AskOne(&survey.Input{Message: "Enter your name:"}, &name, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "john", name)
+	})
+}
+
 func TestPasswordPrompt_SurveyInterrupted(t *testing.T) {
 	t.Parallel()
 
@@ -1,5 +1,15 @@
 package surveyexpect
 
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Netflix/go-expect"
+)
+
+// anyTimes indicates that a prompt may be asked any number of times.
+const anyTimes = -1
+
 // Prompt is a prompt expectation for a survey.
 type Prompt interface {
 	Step
@@ -8,10 +18,43 @@ type Prompt interface {
 type basePrompt struct {
 	parent *Survey
 
+	// The message of the prompt.
+	message Matcher
+
+	// The text that matches the message when the prompt is asked.
+	matched MessageMatch
+
+	// Amount of times this request is still expected to be executed.
 	repeatability int
 
+	// Amount of times this request may still be executed after the expected ones, or anyTimes.
+	optional int
+
 	// Amount of times this request has been executed.
 	totalCalls int
+
+	// The message has been read while looking for the next prompt.
+	read bool
+
+	// The values that are captured from the screen when the prompt is asked.
+	captures []capture
+
+	// The answer that is expected to be rendered next to the message once the prompt is answered.
+	answered *string
+
+	// The name of the question when the prompt is a part of a form.
+	question string
+}
+
+// capture is a value that is captured from the screen when the prompt is asked.
+type capture struct {
+	re  *regexp.Regexp
+	dst *string
+}
+
+// questionName returns the name of the question when the prompt is a part of a form.
+func (p *basePrompt) questionName() string {
+	return p.question
 }
 
 func (p *basePrompt) lock() {
@@ -30,7 +73,34 @@ func (p *basePrompt) times(i int) {
 }
 
 func (p *basePrompt) timesLocked(i int) {
-	p.repeatability = i
+	p.cardinalityLocked(i, 0)
+}
+
+// cardinality sets the number of times the prompt is expected to be asked, and the number of times it may be asked
+// after that.
+func (p *basePrompt) cardinality(expected, optional int) {
+	p.lock()
+	defer p.unlock()
+
+	p.cardinalityLocked(expected, optional)
+}
+
+func (p *basePrompt) cardinalityLocked(expected, optional int) {
+	p.repeatability = expected
+	p.optional = optional
+}
+
+// calledLocked counts a call of the prompt.
+func (p *basePrompt) calledLocked() {
+	switch {
+	case p.repeatability > 0:
+		p.repeatability--
+
+	case p.optional > 0:
+		p.optional--
+	}
+
+	p.totalCalls++
 }
 
 func (p *basePrompt) isDoneLocked(err error) error {
@@ -38,9 +108,184 @@ func (p *basePrompt) isDoneLocked(err error) error {
 		return err
 	}
 
-	if p.repeatability > 0 {
+	if p.repeatability > 0 || p.optional != 0 {
 		return ErrNotFinished
 	}
 
 	return nil
 }
+
+func (p *basePrompt) isOptional() bool {
+	p.lock()
+	defer p.unlock()
+
+	return p.isOptionalLocked()
+}
+
+func (p *basePrompt) isOptionalLocked() bool {
+	return p.repeatability <= 0 && p.optional != 0
+}
+
+// expectedMessages is the message of the prompt.
+func (p *basePrompt) expectedMessages() []Matcher {
+	return []Matcher{p.message}
+}
+
+// messageRead marks that the message has been read, a prompt has only one message.
+func (p *basePrompt) messageRead(_ Matcher, match MessageMatch) {
+	p.lock()
+	defer p.unlock()
+
+	p.read = true
+	p.matchedLocked(match)
+}
+
+// matchedLocked keeps the text that matches the message, its captures are available to the next steps.
+func (p *basePrompt) matchedLocked(match MessageMatch) {
+	p.matched = match
+
+	p.parent.captureLocked(match.Captures)
+}
+
+// text is the text that matches the message when the prompt is asked.
+func (p *basePrompt) text() string {
+	p.lock()
+	defer p.unlock()
+
+	return p.matched.Text
+}
+
+// expectMessage expects the message of the prompt, unless it has been read while looking for the next prompt.
+func (p *basePrompt) expectMessage(c Console) error {
+	p.lock()
+	read := p.read
+	p.read = false
+	p.unlock()
+
+	if read {
+		return nil
+	}
+
+	buf, err := c.Expect(expectMessages(p.message))
+	if err != nil {
+		return err
+	}
+
+	match, _ := p.message.Match(buf)
+
+	p.lock()
+	defer p.unlock()
+
+	p.matchedLocked(match)
+
+	return nil
+}
+
+// captureLocked captures a value from the screen when the prompt is asked.
+func (p *basePrompt) captureLocked(pattern string, dst *string) {
+	p.captures = append(p.captures, capture{re: regexp.MustCompile(pattern), dst: dst})
+}
+
+// captureScreen reads the screen when there is any value to capture. For the last match on the screen, the first
+// group, or the whole match if there is no group, is stored in the destination, and the named groups are added to the
+// captures of the survey.
+func (p *basePrompt) captureScreen(c Console) error {
+	p.lock()
+	captures := p.captures
+	p.unlock()
+
+	if len(captures) == 0 {
+		return nil
+	}
+
+	s, err := readScreen(c)
+	if err != nil {
+		return err
+	}
+
+	p.lock()
+	defer p.unlock()
+
+	for _, cp := range captures {
+		all := cp.re.FindAllStringSubmatch(s.String(), -1)
+		if all == nil {
+			return fmt.Errorf("%w: %q in:\n%s", ErrNothingCaptured, cp.re.String(), s)
+		}
+
+		// The screen may still show the values of the previous prompts, the last one is the most recent.
+		found := all[len(all)-1]
+
+		if cp.dst != nil {
+			value := found[0]
+
+			if len(found) > 1 {
+				value = found[1]
+			}
+
+			*cp.dst = value
+		}
+
+		named := make(map[string]string)
+
+		for i, name := range cp.re.SubexpNames() {
+			if name != "" {
+				named[name] = found[i]
+			}
+		}
+
+		p.parent.captureLocked(named)
+	}
+
+	return nil
+}
+
+// expectAnsweredLocked expects the answer to be rendered next to the message once the prompt is answered.
+func (p *basePrompt) expectAnsweredLocked(answer string) {
+	p.answered = &answer
+}
+
+// readAnswer reads the answer that survey renders once the prompt is answered, and checks it if it is expected. When
+// the answer is echoed, nothing is rendered again, so nothing is read unless it is checked.
+func (p *basePrompt) readAnswer(c Console, echoed bool) error {
+	p.lock()
+	answered := p.answered
+	text := p.matched.Text
+	p.unlock()
+
+	if answered != nil {
+		return expectAnswered(c, text, *answered, echoed)
+	}
+
+	if !echoed {
+		expectAnswer(c, text)
+	}
+
+	return nil
+}
+
+// callsString represents the calls of the prompt as a string, it is empty when the prompt is expected to be asked
+// once and has not been asked yet.
+func (p *basePrompt) callsString() string {
+	if p.repeatability <= 0 || (p.totalCalls == 0 && p.repeatability == 1 && p.optional == 0) {
+		return ""
+	}
+
+	switch p.optional {
+	case 0:
+		return fmt.Sprintf("(called: %d time(s), remaining: %d time(s))", p.totalCalls, p.repeatability)
+
+	case anyTimes:
+		return fmt.Sprintf("(called: %d time(s), remaining: at least %d time(s))", p.totalCalls, p.repeatability)
+
+	default:
+		return fmt.Sprintf("(called: %d time(s), remaining: %d to %d time(s))",
+			p.totalCalls, p.repeatability, p.repeatability+p.optional,
+		)
+	}
+}
+
+// expectAnswer reads the answer that survey shows next to the message once the prompt is answered, so it is not taken
+// as the prompt being asked again. Nothing more is read when the answer is rejected by a validator.
+func expectAnswer(c Console, message string) {
+	_, _ = c.Expect(expect.String(message, validationFeedback("")), expect.EOF, expect.PTSClosed) //nolint: errcheck
+}
@@ -0,0 +1,162 @@
+package surveyexpect
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Netflix/go-expect"
+	"github.com/hinshun/vt10x"
+)
+
+// ScreenSettleTime is how long the terminal has to stay quiet before its screen is read.
+var ScreenSettleTime = 50 * time.Millisecond
+
+// terminalConsole is a Console that renders its output to a virtual terminal.
+type terminalConsole struct {
+	*expect.Console
+
+	term vt10x.Terminal
+
+	// output is everything that the survey writes, including what is not read before the console is closed.
+	output StringWriter
+}
+
+// Screen is a snapshot of the virtual terminal.
+type Screen struct {
+	lines   []string
+	width   int
+	cursorX int
+	cursorY int
+}
+
+// Lines returns the lines of the screen, without the spaces at the end of each line and the empty lines at the bottom.
+func (s Screen) Lines() []string {
+	lines := make([]string, len(s.lines))
+	copy(lines, s.lines)
+
+	return lines
+}
+
+// Width returns the number of columns of the screen, longer lines are wrapped.
+func (s Screen) Width() int {
+	return s.width
+}
+
+// Cursor returns the position of the cursor on the screen.
+func (s Screen) Cursor() (x, y int) {
+	return s.cursorX, s.cursorY
+}
+
+// String represents the screen as a string.
+func (s Screen) String() string {
+	return strings.Join(s.lines, "\n")
+}
+
+// readScreen waits until the terminal is quiet and takes a snapshot of its screen. Everything that is rendered so far
+// is consumed.
+func readScreen(c Console) (Screen, error) {
+	tc, ok := c.(*terminalConsole)
+	if !ok {
+		return Screen{}, ErrScreenUnavailable
+	}
+
+	if _, err := c.Expect(expect.WithTimeout(ScreenSettleTime), readTimeout); err != nil {
+		return Screen{}, err
+	}
+
+	return tc.snapshot(), nil
+}
+
+// snapshot takes a snapshot of the screen as it is rendered so far.
+func (c *terminalConsole) snapshot() Screen {
+	return snapshot(c.term)
+}
+
+// replay renders everything that the survey writes on a new terminal of the same size and takes a snapshot of its
+// screen, for when the console is closed before everything is read.
+func (c *terminalConsole) replay() Screen {
+	c.term.Lock()
+	cols, rows := c.term.Size()
+	c.term.Unlock()
+
+	return render(c.output.String(), cols, rows)
+}
+
+// history renders everything that the survey writes on a new terminal that is tall enough to keep all the lines, even
+// those that scroll off the screen.
+func (c *terminalConsole) history() Screen {
+	c.term.Lock()
+	cols, rows := c.term.Size()
+	c.term.Unlock()
+
+	output := c.output.String()
+
+	return render(output, cols, rows+strings.Count(output, "\n"))
+}
+
+// render renders the output on a new terminal of the given size and takes a snapshot of its screen.
+func render(output string, cols, rows int) Screen {
+	term := vt10x.New(vt10x.WithSize(cols, rows))
+
+	// The pty translates the new lines, the recorded output does not.
+	_, _ = term.Write([]byte(strings.ReplaceAll(output, "\n", "\r\n"))) //nolint: errcheck
+
+	return snapshot(term)
+}
+
+// snapshot takes a snapshot of the screen of a terminal.
+func snapshot(term vt10x.Terminal) Screen {
+	term.Lock()
+	defer term.Unlock()
+
+	cols, rows := term.Size()
+	cursor := term.Cursor()
+
+	s := Screen{
+		lines:   make([]string, 0, rows),
+		width:   cols,
+		cursorX: cursor.X,
+		cursorY: cursor.Y,
+	}
+
+	for y := 0; y < rows; y++ {
+		var sb strings.Builder
+
+		for x := 0; x < cols; x++ {
+			if r := term.Cell(x, y).Char; r != 0 {
+				sb.WriteRune(r)
+			} else {
+				sb.WriteRune(' ')
+			}
+		}
+
+		s.lines = append(s.lines, strings.TrimRight(sb.String(), " "))
+	}
+
+	for len(s.lines) > 0 && s.lines[len(s.lines)-1] == "" {
+		s.lines = s.lines[:len(s.lines)-1]
+	}
+
+	return s
+}
+
+// readTimeout stops reading when the read deadline is exceeded.
+func readTimeout(opts *expect.ExpectOpts) error {
+	opts.Matchers = append(opts.Matchers, timeoutMatcher{})
+
+	return nil
+}
+
+// timeoutMatcher matches the read timeout error.
+type timeoutMatcher struct{}
+
+func (timeoutMatcher) Match(v interface{}) bool {
+	err, ok := v.(error)
+
+	return ok && os.IsTimeout(err)
+}
+
+func (timeoutMatcher) Criteria() interface{} {
+	return os.ErrDeadlineExceeded
+}
@@ -6,8 +6,11 @@ var _ Prompt = (*SelectPrompt)(nil)
 type SelectPrompt struct {
 	*basePrompt
 
-	message string
-	steps   *InlineSteps
+	defaultValue *string
+	texts        *optionTexts
+	steps        *InlineSteps
+	vimMode      bool
+	interrupted  bool
 }
 
 func (p *SelectPrompt) append(steps ...Step) *SelectPrompt {
@@ -32,7 +35,18 @@ func (p *SelectPrompt) ShowHelp(help string, options ...string) *SelectPrompt {
 //	Survey.ExpectSelect("Select a language:").
 //		Type("Eng")
 func (p *SelectPrompt) Type(s string) *SelectPrompt {
-	return p.append(typeAnswer(s))
+	p.lock()
+	defer p.unlock()
+
+	// Survey leaves vim mode when the filter is typed, but "j" and "k" would move the cursor instead.
+	if p.vimMode {
+		p.steps.Append(toggleVimMode())
+		p.vimMode = false
+	}
+
+	p.steps.Append(typeAnswer(s))
+
+	return p
 }
 
 // Tab sends the TAB key the indicated times. Default is 1 when omitted.
@@ -44,6 +58,15 @@ func (p *SelectPrompt) Tab(times ...int) *SelectPrompt {
 	return p.append(repeatStep(pressTab(), times...)...)
 }
 
+// Press sends the keys, see ParseKeys for the notation.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	Press(surveyexpect.KeyDown, surveyexpect.KeyDown).
+//			Press(surveyexpect.MustParseKeys("Eng<TAB>")...)
+func (p *SelectPrompt) Press(keys ...Key) *SelectPrompt {
+	return p.append(pressKeys(keys...))
+}
+
 // Interrupt sends ^C and ends the sequence.
 //
 //	   Survey.ExpectSelect("Select a language:").
@@ -51,6 +74,11 @@ func (p *SelectPrompt) Tab(times ...int) *SelectPrompt {
 func (p *SelectPrompt) Interrupt() {
 	p.append(pressInterrupt())
 	p.steps.Close()
+
+	p.lock()
+	defer p.unlock()
+
+	p.interrupted = true
 }
 
 // Enter sends the ENTER key and ends the sequence.
@@ -63,6 +91,48 @@ func (p *SelectPrompt) Enter() {
 	p.steps.Close()
 }
 
+// Choose moves the cursor to the option, confirms that it is highlighted and sends the ENTER key. The list is scrolled
+// when the option is not visible. It ends the sequence.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//			Choose("English")
+func (p *SelectPrompt) Choose(option string) {
+	p.append(chooseOption(p.texts.option(option)))
+	p.steps.Close()
+}
+
+// ChooseIndex moves the cursor to the option at the given index, starting from 0, in the options of the prompt or in
+// the options that match the filter when there is one. It confirms that the option is highlighted and sends the ENTER
+// key. The list is scrolled when the option is not visible. It ends the sequence.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//			ChooseIndex(12)
+func (p *SelectPrompt) ChooseIndex(index int) {
+	p.append(chooseOption(optionIndex(index)))
+	p.steps.Close()
+}
+
+// AnswerSequence chooses a different option each time the prompt is asked, the first option for the first time, the
+// second one for the second time and so on. The prompt is expected to be asked as many times as the options. It ends
+// the sequence, the steps before it are done every time.
+//
+//	   Survey.ExpectSelect("Select a host:").
+//			AnswerSequence("alpha", "beta", "gamma")
+func (p *SelectPrompt) AnswerSequence(options ...string) {
+	answers := make([]Step, 0, len(options))
+
+	for _, o := range options {
+		answers = append(answers, chooseOption(p.texts.option(o)))
+	}
+
+	p.lock()
+	a := sequenceAnswers(p.basePrompt, answers...)
+	p.unlock()
+
+	p.append(a)
+	p.steps.Close()
+}
+
 // Delete sends the DELETE key the indicated times. Default is 1 when omitted.
 //
 //	   Survey.ExpectSelect("Select a language:").
@@ -72,22 +142,77 @@ func (p *SelectPrompt) Delete(times ...int) *SelectPrompt {
 	return p.append(repeatStep(pressDelete(), times...)...)
 }
 
-// MoveUp sends the ARROW UP key the indicated times. Default is 1 when omitted.
+// MoveUp sends the ARROW UP key, or "k" in vim mode, the indicated times. Default is 1 when omitted.
 //
 //	   Survey.ExpectSelect("Select a language:").
 //	   	Type("Eng").
 //			MoveUp()
 func (p *SelectPrompt) MoveUp(times ...int) *SelectPrompt {
-	return p.append(repeatStep(pressArrowUp(), times...)...)
+	return p.append(repeatStep(p.moveKey(pressVimUp(), pressArrowUp()), times...)...)
 }
 
-// MoveDown sends the ARROW DOWN key the indicated times. Default is 1 when omitted.
+// MoveDown sends the ARROW DOWN key, or "j" in vim mode, the indicated times. Default is 1 when omitted.
 //
 //	   Survey.ExpectSelect("Select a language:").
 //	   	Type("Eng").
 //			MoveDown()
 func (p *SelectPrompt) MoveDown(times ...int) *SelectPrompt {
-	return p.append(repeatStep(pressArrowDown(), times...)...)
+	return p.append(repeatStep(p.moveKey(pressVimDown(), pressArrowDown()), times...)...)
+}
+
+// VimMode indicates that the prompt is in vim mode, like survey.Select{VimMode: true}. MoveUp and MoveDown send "k" and
+// "j", and Type leaves vim mode before typing the filter.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	VimMode().
+//			MoveDown()
+func (p *SelectPrompt) VimMode() *SelectPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.vimMode = true
+
+	return p
+}
+
+// ToggleVimMode sends the ESC key to enter or leave vim mode.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	Type("Eng").
+//	   	ToggleVimMode().
+//			MoveDown()
+func (p *SelectPrompt) ToggleVimMode() *SelectPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.steps.Append(toggleVimMode())
+	p.vimMode = !p.vimMode
+
+	return p
+}
+
+// ProbeVimMode finds out whether vim mode is enabled or disabled, and expects it. Survey does not show the mode, so "j"
+// is sent to see whether it moves the cursor or goes to the filter. The key is then taken back with "k" or BACKSPACE.
+// Changing the filter may move the cursor, so the option that was highlighted is highlighted again, and the list is
+// expected to be rendered as before. The probe fails when the list has no option, because survey leaves vim mode when
+// "j" goes to the filter.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	ProbeVimMode(true).
+//			MoveDown()
+func (p *SelectPrompt) ProbeVimMode(enabled bool) *SelectPrompt {
+	return p.append(probeVimMode(p.text, enabled, false))
+}
+
+func (p *SelectPrompt) moveKey(vim, arrow *Action) *Action {
+	p.lock()
+	defer p.unlock()
+
+	if p.vimMode {
+		return vim
+	}
+
+	return arrow
 }
 
 // ExpectOptions expects a list of options.
@@ -99,29 +224,294 @@ func (p *SelectPrompt) ExpectOptions(options ...string) *SelectPrompt {
 	return p.append(expectSelect(options...))
 }
 
+// WithDescriptions declares the descriptions that survey renders next to the options, by their labels, without
+// expecting the options. Survey renders an option with a description as "label - description", so the steps only find
+// an option by its label once its description is declared here or with ExpectOptionsWithDescription.
+//
+//	   Survey.ExpectSelect("Select a plan:").
+//	   	WithDescriptions(map[string]string{"Free": "$0/month", "Pro": "$10/month"}).
+//			Choose("Pro")
+func (p *SelectPrompt) WithDescriptions(descriptions map[string]string) *SelectPrompt {
+	p.texts.describe(descriptions)
+
+	return p
+}
+
+// ExpectOptionsWithDescription expects exactly the visible options with their descriptions, in order, and declares
+// the descriptions like WithDescriptions.
+//
+//	   Survey.ExpectSelect("Select a plan:").
+//			ExpectOptionsWithDescription(
+//				surveyexpect.OptionWithDescription{Option: "Free", Description: "$0/month", Highlighted: true},
+//				surveyexpect.OptionWithDescription{Option: "Pro", Description: "$10/month"},
+//			)
+func (p *SelectPrompt) ExpectOptionsWithDescription(options ...OptionWithDescription) *SelectPrompt {
+	return p.append(expectOptionsWithDescription(false, p.texts, options...))
+}
+
+// ExpectExactOptions expects exactly the list of visible options, in order. The highlighted option is prefixed with
+// "> ".
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	Type("Eng").
+//			ExpectExactOptions("> English")
+func (p *SelectPrompt) ExpectExactOptions(options ...string) *SelectPrompt {
+	return p.append(expectExactOptions(false, options...))
+}
+
+// ExpectWindow expects the visible options to be the given number of options from the index start, starting from 0,
+// in the options of the prompt or in the options that match the filter when there is one. Survey does not show the
+// index, so the cursor may be moved up to the first page and back, which shows the same options again.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	ScrollTo("Vietnamese").
+//			ExpectWindow(5, 7)
+func (p *SelectPrompt) ExpectWindow(start, size int) *SelectPrompt {
+	return p.append(expectWindow(false, start, size))
+}
+
+// ExpectVisibleOptions expects exactly the visible options, in order, regardless of the highlighted option.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	ScrollTo("Vietnamese").
+//			ExpectVisibleOptions("Spanish", "Thai", "Vietnamese")
+func (p *SelectPrompt) ExpectVisibleOptions(options ...string) *SelectPrompt {
+	return p.append(expectVisibleOptions(false, p.texts, options...))
+}
+
+// ScrollTo scrolls the list until the option is visible, even if it is above the visible page. The cursor moves while
+// scrolling, but the option is not necessarily highlighted.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	ScrollTo("Vietnamese").
+//			ExpectVisibleOptions("Spanish", "Thai", "Vietnamese")
+func (p *SelectPrompt) ScrollTo(option string) *SelectPrompt {
+	return p.append(scrollTo(false, p.texts, option))
+}
+
+// ExpectHighlighted expects the option to be highlighted.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	MoveDown().
+//			ExpectHighlighted("English")
+func (p *SelectPrompt) ExpectHighlighted(option string) *SelectPrompt {
+	return p.append(expectHighlightedOption(false, p.texts, option))
+}
+
+// ExpectFilter expects the text that filters the options.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	Type("Eng").
+//			ExpectFilter("Eng")
+func (p *SelectPrompt) ExpectFilter(filter string) *SelectPrompt {
+	return p.append(expectFilter(p.text, filter))
+}
+
+// ExpectDefault expects the option to be highlighted when the prompt is shown. The check consumes the first rendering
+// of the list, so ExpectOptions that follows applies to the list rendered after the next action.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	ExpectDefault("English").
+//			Enter()
+func (p *SelectPrompt) ExpectDefault(option string) *SelectPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.defaultValue = &option
+
+	return p
+}
+
+// ExpectAnswered expects the option that survey renders next to the message once the prompt is answered, after the
+// transformer of the question is applied.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	ExpectAnswered("English").
+//			Enter()
+func (p *SelectPrompt) ExpectAnswered(answer string) *SelectPrompt {
+	p.lock()
+	defer p.unlock()
+
+	p.expectAnsweredLocked(answer)
+
+	return p
+}
+
 // Do runs the step.
 func (p *SelectPrompt) Do(c Console) error {
-	if _, err := c.ExpectString(p.message); err != nil {
+	if err := p.expectMessage(c); err != nil {
+		return err
+	}
+
+	if err := p.expectDefault(c); err != nil {
+		return err
+	}
+
+	// The steps are consumed while they are done, keep a copy for the next time the prompt is asked.
+	p.lock()
+	next := p.steps.clone()
+	p.unlock()
+
+	if err := p.steps.Do(c); err != nil {
 		return err
 	}
 
-	return p.steps.Do(c)
+	// The prompt is answered when the sequence ends, unless it is interrupted.
+	p.lock()
+	answered := p.steps.isClosed() && !p.interrupted
+	p.unlock()
+
+	if answered {
+		if err := p.readAnswer(c, false); err != nil {
+			return err
+		}
+	}
+
+	return p.repeat(next)
+}
+
+// repeat counts the call and sets the steps to be done again if the prompt is expected to be asked again.
+func (p *SelectPrompt) repeat(next *InlineSteps) error {
+	p.lock()
+	defer p.unlock()
+
+	p.calledLocked()
+
+	// Times are discarded due to the interruption.
+	if p.interrupted {
+		return nil
+	}
+
+	err := p.isDoneLocked(nil)
+	if err != nil {
+		p.steps = next
+	}
+
+	return err
+}
+
+func (p *SelectPrompt) expectDefault(c Console) error {
+	if p.defaultValue == nil {
+		return nil
+	}
+
+	rendered, err := readRenderedPrompt(c)
+	if err != nil {
+		return err
+	}
+
+	if actual := highlightedOption(parseRenderedOptions(rendered, false)); !p.texts.isLabel(actual, *p.defaultValue) {
+		return unexpectedDefault(*p.defaultValue, actual)
+	}
+
+	return nil
 }
 
 // String represents the expectation as a string.
 func (p *SelectPrompt) String() string {
 	var sb stringsBuilder
 
-	return sb.WriteLabelLinef("Expect", "Select Prompt").
-		WriteLabelLinef("Message", "%q", p.message).
-		WriteString(p.steps.String()).
-		String()
+	sb.WriteLabelLinef("Expect", "Select Prompt").
+		WriteLabelLinef("Message", "%s", p.message)
+
+	if p.question != "" {
+		sb.WriteLabelLinef("Question", "%q", p.question)
+	}
+
+	if p.defaultValue != nil {
+		sb.WriteLabelLinef("Default", "%q", *p.defaultValue)
+	}
+
+	if p.answered != nil {
+		sb.WriteLabelLinef("Answered", "%q", *p.answered)
+	}
+
+	sb.WriteString(p.steps.String())
+
+	if calls := p.callsString(); calls != "" {
+		sb.WriteRune('\n').
+			WriteString(calls)
+	}
+
+	return sb.String()
+}
+
+// Once indicates that the message should only be asked once.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	Once().
+//			Enter()
+func (p *SelectPrompt) Once() *SelectPrompt {
+	return p.Times(1)
+}
+
+// Twice indicates that the message should only be asked twice.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	Twice().
+//			Enter()
+func (p *SelectPrompt) Twice() *SelectPrompt {
+	return p.Times(2)
+}
+
+// Times indicates that the message should only be asked the indicated number of times. The sequence is done every
+// time the prompt is asked.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	Times(5).
+//			Enter()
+func (p *SelectPrompt) Times(i int) *SelectPrompt {
+	p.times(i)
+
+	return p
+}
+
+// Maybe indicates that the message may be asked once, or not at all.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	Maybe().
+//			Enter()
+func (p *SelectPrompt) Maybe() *SelectPrompt {
+	return p.AtMost(1)
+}
+
+// AtLeast indicates that the message should be asked at least the indicated number of times.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	AtLeast(2).
+//			Enter()
+func (p *SelectPrompt) AtLeast(i int) *SelectPrompt {
+	p.cardinality(i, anyTimes)
+
+	return p
+}
+
+// AtMost indicates that the message may be asked up to the indicated number of times, or not at all.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	AtMost(3).
+//			Enter()
+func (p *SelectPrompt) AtMost(i int) *SelectPrompt {
+	p.cardinality(0, i)
+
+	return p
+}
+
+// AnyTimes indicates that the message may be asked any number of times, or not at all.
+//
+//	   Survey.ExpectSelect("Select a language:").
+//	   	AnyTimes().
+//			Enter()
+func (p *SelectPrompt) AnyTimes() *SelectPrompt {
+	p.cardinality(0, anyTimes)
+
+	return p
 }
 
-func newSelect(parent *Survey, message string) *SelectPrompt {
+func newSelect(parent *Survey, message Matcher) *SelectPrompt {
 	return &SelectPrompt{
-		basePrompt: &basePrompt{parent: parent},
-		message:    message,
+		basePrompt: &basePrompt{parent: parent, message: message},
+		texts:      newOptionTexts(),
 		steps:      inlineSteps(),
 	}
 }
@@ -1,6 +1,7 @@
 package surveyexpect_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ func TestSelectPrompt(t *testing.T) {
 	testCases := []struct {
 		scenario       string
 		expectSurvey   surveyexpect.Expector
+		defaultValue   interface{}
 		help           string
 		showHelp       bool
 		options        []string
@@ -32,6 +34,125 @@ func TestSelectPrompt(t *testing.T) {
 			}),
 			expectedAnswer: "France",
 		},
+		{
+			scenario: "press keys",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					Press(surveyexpect.KeyDown, surveyexpect.KeyDown).
+					ExpectHighlighted("Malaysia").
+					Press(surveyexpect.MustParseKeys("<UP><ENTER>")...)
+			}),
+			expectedAnswer: "Germany",
+		},
+		{
+			scenario: "enter with default",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					ExpectDefault("Singapore").
+					Enter()
+			}),
+			defaultValue:   "Singapore",
+			expectedAnswer: "Singapore",
+		},
+		{
+			scenario: "choose by label",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					Choose("Thailand")
+			}),
+			expectedAnswer: "Thailand",
+		},
+		{
+			scenario: "choose by label from the next page",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					Choose("Vietnam")
+			}),
+			expectedAnswer: "Vietnam",
+		},
+		{
+			scenario: "choose by label after filtering",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					Type("United").
+					Choose("United States")
+			}),
+			expectedAnswer: "United States",
+		},
+		{
+			scenario: "choose by label above the visible page",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					MoveDown(7).
+					ExpectHighlighted("Vietnam").
+					Choose("France")
+			}),
+			expectedAnswer: "France",
+		},
+		{
+			scenario: "choose by label above the default",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					ExpectHighlighted("Vietnam").
+					Choose("France")
+			}),
+			defaultValue:   "Vietnam",
+			expectedAnswer: "France",
+		},
+		{
+			scenario: "choose by index",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					ChooseIndex(2)
+			}),
+			expectedAnswer: "Malaysia",
+		},
+		{
+			scenario: "choose by index from the next page",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					ChooseIndex(7)
+			}),
+			expectedAnswer: "Vietnam",
+		},
+		{
+			scenario: "choose by index above the visible page",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					MoveDown(7).
+					ExpectHighlighted("Vietnam").
+					ChooseIndex(0)
+			}),
+			expectedAnswer: "France",
+		},
+		{
+			scenario: "choose by index after filtering",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					Type("United").
+					ChooseIndex(1)
+			}),
+			expectedAnswer: "United States",
+		},
+		{
+			scenario: "expect the exact list",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					Type("United").
+					ExpectFilter("United").
+					ExpectExactOptions(
+						"> United Kingdom",
+						"United States",
+					).
+					MoveDown().
+					ExpectHighlighted("United States").
+					Delete(6).
+					ExpectFilter("").
+					ExpectHighlighted("Germany").
+					Enter()
+			}),
+			expectedAnswer: "Germany",
+		},
 		{
 			scenario: "with help and ask for it",
 			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
@@ -104,6 +225,7 @@ func TestSelectPrompt(t *testing.T) {
 			p := &survey.SelectTemplateData{
 				Select: survey.Select{
 					Message: "Select a country",
+					Default: tc.defaultValue,
 					Help:    tc.help,
 					Options: []string{
 						"France",
@@ -136,6 +258,673 @@ func TestSelectPrompt(t *testing.T) {
 	}
 }
 
+func TestSelectPrompt_UnexpectedDefault(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(100 * time.Millisecond)
+
+		s.ExpectSelect("Select a country").
+			ExpectDefault("Germany").
+			Enter()
+	})(testingT)
+
+	p := &survey.Select{
+		Message: "Select a country",
+		Options: []string{
+			"France",
+			"Germany",
+		},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	expectedError := `unexpected default value: expected "Germany", got "France"`
+
+	assert.Contains(t, testingT.ErrorString(), expectedError)
+}
+
+func TestSelectPrompt_ChooseNotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario      string
+		expectSurvey  surveyexpect.Expector
+		expectedError string
+	}{
+		{
+			scenario: "label",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectSelect("Select a country").
+					Choose("Japan")
+			}),
+			expectedError: "option not found: \"Japan\", visible options:\n> France\n  Germany\n  Malaysia",
+		},
+		{
+			scenario: "index",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectSelect("Select a country").
+					MoveDown().
+					ChooseIndex(3)
+			}),
+			expectedError: "option not found: #3, visible options:\n  France\n> Germany\n  Malaysia",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			testingT := T()
+			s := tc.expectSurvey(testingT)
+
+			p := &survey.Select{
+				Message: "Select a country",
+				Options: []string{"France", "Germany", "Malaysia"},
+			}
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				var answer string
+				_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+			})
+
+			assert.Contains(t, testingT.ErrorString(), tc.expectedError)
+		})
+	}
+}
+
+func TestSelectPrompt_Pagination(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectSelect("Select a region").
+			ExpectWindow(0, 5).
+			ExpectVisibleOptions("Region 01", "Region 02", "Region 03", "Region 04", "Region 05").
+			ScrollTo("Region 12").
+			ExpectWindow(9, 5).
+			ExpectVisibleOptions("Region 10", "Region 11", "Region 12", "Region 13", "Region 14").
+			ExpectHighlighted("Region 12").
+			ScrollTo("Region 02").
+			ExpectWindow(0, 5).
+			ExpectVisibleOptions("Region 01", "Region 02", "Region 03", "Region 04", "Region 05").
+			Choose("Region 19")
+	})(t)
+
+	p := &survey.Select{
+		Message: "Select a region",
+		Options: regions(20),
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio), survey.WithPageSize(5))
+
+		assert.Equal(t, "Region 19", answer)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSelectPrompt_Description(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectSelect("Select a plan").
+			ExpectOptionsWithDescription(
+				surveyexpect.OptionWithDescription{Option: "Free", Description: "$0/month", Highlighted: true},
+				surveyexpect.OptionWithDescription{Option: "Pro", Description: "$10/month"},
+				surveyexpect.OptionWithDescription{Option: "Enterprise"},
+			).
+			ExpectHighlighted("Free").
+			MoveDown().
+			ExpectExactOptions(
+				"Free - $0/month",
+				"> Pro - $10/month",
+				"Enterprise",
+			).
+			Choose("Enterprise")
+	})(t)
+
+	p := &survey.Select{
+		Message: "Select a plan",
+		Options: []string{"Free", "Pro", "Enterprise"},
+		Description: func(value string, _ int) string {
+			return map[string]string{
+				"Free": "$0/month",
+				"Pro":  "$10/month",
+			}[value]
+		},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.Equal(t, "Enterprise", answer)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSelectPrompt_UnexpectedDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario      string
+		options       []string
+		expectedError string
+	}{
+		{
+			scenario: "description",
+			options:  []string{"Free", "Pro"},
+			expectedError: `there are remaining expectations that were not met:
+
+Expect : Select Prompt
+Message: "Select a plan"
+Expect a select list:
+> Free - $0/month
+  Pro - $20/month`,
+		},
+		{
+			scenario: "extra option",
+			options:  []string{"Free", "Pro", "Enterprise"},
+			expectedError: `there are remaining expectations that were not met:
+
+Expect : Select Prompt
+Message: "Select a plan"
+Expect a select list:
+> Free - $0/month
+  Pro - $20/month`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			testingT := T()
+			s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(50 * time.Millisecond)
+
+				s.ExpectSelect("Select a plan").
+					ExpectOptionsWithDescription(
+						surveyexpect.OptionWithDescription{Option: "Free", Description: "$0/month", Highlighted: true},
+						surveyexpect.OptionWithDescription{Option: "Pro", Description: "$20/month"},
+					)
+			})(testingT)
+
+			p := &survey.Select{
+				Message: "Select a plan",
+				Options: tc.options,
+				Description: func(value string, _ int) string {
+					return map[string]string{
+						"Free": "$0/month",
+						"Pro":  "$10/month",
+					}[value]
+				},
+			}
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				var answer string
+				_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+			})
+
+			assert.EqualError(t, s.ExpectationsWereMet(), tc.expectedError)
+		})
+	}
+}
+
+func TestSelectPrompt_LabelWithSeparator(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectSelect("Select a version").
+			WithDescriptions(map[string]string{"Go": "latest"}).
+			ExpectDefault("Go - stable").
+			ExpectVisibleOptions("Go - stable", "Go").
+			Choose("Go")
+	})(t)
+
+	p := &survey.Select{
+		Message: "Select a version",
+		Options: []string{"Go - stable", "Go"},
+		Description: func(value string, _ int) string {
+			if value == "Go" {
+				return "latest"
+			}
+
+			return ""
+		},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.Equal(t, "Go", answer)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSelectPrompt_UnexpectedList(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario      string
+		expectSurvey  surveyexpect.Expector
+		expectedError string
+	}{
+		{
+			scenario: "options",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectSelect("Select a country").
+					ExpectExactOptions(
+						"> France",
+						"Malaysia",
+					).
+					Enter()
+			}),
+			expectedError: "unexpected options: expected:\n> France\n  Malaysia\ngot:\n> France\n  Germany\n  Malaysia",
+		},
+		{
+			scenario: "window size",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectSelect("Select a country").
+					ExpectWindow(0, 2).
+					Enter()
+			}),
+			expectedError: "unexpected options: expected 2 visible options from #0, got 3 from #0:\n> France\n  Germany\n  Malaysia",
+		},
+		{
+			scenario: "window start",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectSelect("Select a country").
+					MoveDown(2).
+					ExpectWindow(1, 3).
+					Enter()
+			}),
+			expectedError: "unexpected options: expected 3 visible options from #1, got 3 from #0:\n  France\n  Germany\n> Malaysia",
+		},
+		{
+			scenario: "visible options",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectSelect("Select a country").
+					ExpectVisibleOptions("France", "Malaysia").
+					Enter()
+			}),
+			expectedError: "unexpected options: expected visible options [\"France\" \"Malaysia\"], got:\n> France\n  Germany\n  Malaysia",
+		},
+		{
+			scenario: "highlighted",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectSelect("Select a country").
+					ExpectHighlighted("Germany").
+					Enter()
+			}),
+			expectedError: "unexpected highlighted option: expected \"Germany\", got:\n> France\n  Germany\n  Malaysia",
+		},
+		{
+			scenario: "filter",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectSelect("Select a country").
+					Type("Ma").
+					ExpectFilter("Fr").
+					Enter()
+			}),
+			expectedError: `unexpected filter: expected "Fr", got "Ma"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			testingT := T()
+			s := tc.expectSurvey(testingT)
+
+			p := &survey.Select{
+				Message: "Select a country",
+				Options: []string{"France", "Germany", "Malaysia"},
+			}
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				var answer string
+				_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+			})
+
+			assert.Contains(t, testingT.ErrorString(), tc.expectedError)
+		})
+	}
+}
+
+func TestSelectPrompt_VimMode(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectSelect("Select a country").
+			VimMode().
+			ProbeVimMode(true).
+			MoveDown(2).
+			ExpectHighlighted("Japan").
+			MoveUp().
+			ExpectHighlighted("Germany").
+			Type("ja").
+			ExpectFilter("ja").
+			ProbeVimMode(false).
+			ToggleVimMode().
+			ProbeVimMode(true).
+			Enter()
+	})(t)
+
+	p := &survey.Select{
+		Message: "Select a country",
+		Options: []string{"France", "Germany", "Japan", "Malaysia"},
+		VimMode: true,
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+		assert.Equal(t, "Japan", answer)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSelectPrompt_ProbeVimModeKeepsHighlight(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario     string
+		expectSurvey surveyexpect.Expector
+		vimMode      bool
+	}{
+		{
+			scenario: "vim mode at the last option",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					VimMode().
+					MoveDown(3).
+					ProbeVimMode(true).
+					ExpectHighlighted("Malaysia").
+					Enter()
+			}),
+			vimMode: true,
+		},
+		{
+			scenario: "no vim mode",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					MoveDown(3).
+					ProbeVimMode(false).
+					ExpectHighlighted("Malaysia").
+					ExpectFilter("").
+					Enter()
+			}),
+		},
+		{
+			scenario: "vim mode after filtering",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					Type("a").
+					ToggleVimMode().
+					MoveDown(3).
+					ProbeVimMode(true).
+					ExpectFilter("a").
+					ExpectHighlighted("Malaysia").
+					Enter()
+			}),
+		},
+		{
+			scenario: "no vim mode after filtering",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a country").
+					Type("a").
+					MoveDown(3).
+					ProbeVimMode(false).
+					ExpectFilter("a").
+					ExpectHighlighted("Malaysia").
+					Enter()
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			p := &survey.Select{
+				Message: "Select a country",
+				Options: []string{"France", "Germany", "Japan", "Malaysia"},
+				VimMode: tc.vimMode,
+			}
+
+			// Start the survey.
+			tc.expectSurvey(t).Start(func(stdio terminal.Stdio) {
+				var answer string
+				err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+				assert.Equal(t, "Malaysia", answer)
+				assert.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestSelectPrompt_UnexpectedVimMode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario      string
+		expectSurvey  surveyexpect.Expector
+		expectedError string
+	}{
+		{
+			scenario: "disabled",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectSelect("Select a country").
+					ProbeVimMode(true).
+					Enter()
+			}),
+			expectedError: "unexpected vim mode: expected enabled, got disabled",
+		},
+		{
+			scenario: "no option",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.WithTimeout(time.Second)
+
+				s.ExpectSelect("Select a country").
+					Type("x").
+					ToggleVimMode().
+					ProbeVimMode(true).
+					Enter()
+			}),
+			expectedError: "vim mode is unknown: there is no option to move to",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			testingT := T()
+			s := tc.expectSurvey(testingT)
+
+			p := &survey.Select{
+				Message: "Select a country",
+				Options: []string{"France", "Germany", "Malaysia"},
+			}
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				var answer string
+				_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+			})
+
+			assert.Contains(t, testingT.ErrorString(), tc.expectedError)
+		})
+	}
+}
+
+func TestSelectPrompt_AnswerSequence(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectSelect("Select a host").
+			ExpectDefault("alpha").
+			AnswerSequence("beta", "alpha", "gamma")
+	})(t)
+
+	p := &survey.Select{
+		Message: "Select a host",
+		Options: []string{"alpha", "beta", "gamma"},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answers []string
+
+		for i := 0; i < 3; i++ {
+			var answer string
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+
+			answers = append(answers, answer)
+		}
+
+		assert.Equal(t, []string{"beta", "alpha", "gamma"}, answers)
+	})
+}
+
+func TestSelectPrompt_ExpectAnswered(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectSelect("Select a host").
+			WithDescriptions(map[string]string{
+				"alpha": "the alpha host",
+				"beta":  "the beta host",
+				"gamma": "the gamma host",
+			}).
+			ExpectAnswered("beta").
+			Twice().
+			Choose("beta")
+	})(t)
+
+	p := &survey.Select{
+		Message: "Select a host",
+		Options: []string{"alpha", "beta", "gamma"},
+		Description: func(value string, _ int) string {
+			return "the " + value + " host"
+		},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		for i := 0; i < 2; i++ {
+			var answer string
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+			assert.Equal(t, "beta", answer)
+		}
+	})
+}
+
+func TestSelectPrompt_Times(t *testing.T) {
+	t.Parallel()
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectSelect("Select a host").
+			Twice().
+			MoveDown().
+			ExpectHighlighted("beta").
+			Enter()
+	})(t)
+
+	p := &survey.Select{
+		Message: "Select a host",
+		Options: []string{"alpha", "beta", "gamma"},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		for i := 0; i < 2; i++ {
+			var answer string
+			err := survey.AskOne(p, &answer, options.WithStdio(stdio))
+
+			assert.Equal(t, "beta", answer)
+			assert.NoError(t, err)
+		}
+	})
+}
+
+func TestSelectPrompt_AnswerSequenceNotFinished(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(time.Second)
+
+		s.ExpectSelect("Select a host").
+			AnswerSequence("beta", "alpha", "gamma")
+	})(testingT)
+
+	expectedError := `there are remaining expectations that were not met:
+
+Expect : Select Prompt
+Message: "Select a host"
+#2 choose "alpha", #3 choose "gamma"
+(called: 1 time(s), remaining: 2 time(s))`
+
+	p := &survey.Select{
+		Message: "Select a host",
+		Options: []string{"alpha", "beta", "gamma"},
+	}
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		_ = survey.AskOne(p, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.EqualError(t, s.ExpectationsWereMet(), expectedError)
+}
+
 func TestSelectPrompt_NoHelpButStillExpect(t *testing.T) {
 	t.Parallel()
 
@@ -259,3 +1048,13 @@ func TestSelectPrompt_SurveyInterrupted(t *testing.T) {
 		})
 	}
 }
+
+func regions(count int) []string {
+	result := make([]string, 0, count)
+
+	for i := 1; i <= count; i++ {
+		result = append(result, fmt.Sprintf("Region %02d", i))
+	}
+
+	return result
+}
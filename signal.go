@@ -36,3 +36,14 @@ func NewSignal() *Signal {
 		ch: make(chan struct{}, 1),
 	}
 }
+
+// isDone checks whether the signal was notified without blocking.
+func isDone(s *Signal) bool {
+	select {
+	case <-s.Done():
+		return true
+
+	default:
+		return false
+	}
+}
@@ -2,10 +2,26 @@ package surveyexpect
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/Netflix/go-expect"
+)
+
+var (
+	_ expectedStep = (*ConfirmPrompt)(nil)
+	_ expectedStep = (*EditorPrompt)(nil)
+	_ expectedStep = (*InputPrompt)(nil)
+	_ expectedStep = (*MultilinePrompt)(nil)
+	_ expectedStep = (*MultiSelectPrompt)(nil)
+	_ expectedStep = (*PasswordPrompt)(nil)
+	_ expectedStep = (*SelectPrompt)(nil)
+	_ expectedStep = (*UnorderedSteps)(nil)
+	_ expectedStep = (*OneOfSteps)(nil)
+	_ expectedStep = (*RepeatedSteps)(nil)
 )
 
 // Step is an execution step for a survey.
@@ -17,11 +33,41 @@ type Step interface {
 	String() string
 }
 
+// expectedStep is a step that starts when one of its messages is asked. It may be optional, so it is skipped when
+// another step is asked instead.
+type expectedStep interface {
+	Step
+
+	// isOptional checks whether the step has been done as many times as expected and may still be done again.
+	isOptional() bool
+
+	// isOptionalLocked is isOptional when the survey lock is already held.
+	isOptionalLocked() bool
+
+	// expectedMessages are the messages that start the step.
+	expectedMessages() []Matcher
+
+	// messageRead marks that the message has been read while looking for the next step.
+	messageRead(message Matcher, match MessageMatch)
+}
+
+// readMessage is a message that has been read while looking for the next step.
+type readMessage struct {
+	message Matcher
+	match   MessageMatch
+}
+
 // Steps is a chain of Step.
 type Steps struct {
 	steps  []Step
 	closed bool
 
+	// inAnyOrder matches the steps in any order instead of running the first one.
+	inAnyOrder bool
+
+	// read is the message that has been read while looking for this steps.
+	read *readMessage
+
 	mu sync.Mutex
 }
 
@@ -43,6 +89,30 @@ func (s *Steps) Close() {
 	s.closed = true
 }
 
+// isClosed checks whether the steps are closed.
+func (s *Steps) isClosed() bool {
+	s.lock()
+	defer s.unlock()
+
+	return s.closed
+}
+
+// reopen reopens the steps, so more steps could be appended.
+func (s *Steps) reopen() {
+	s.lock()
+	defer s.unlock()
+
+	s.closed = false
+}
+
+// matchInOrder sets whether the steps are matched in order.
+func (s *Steps) matchInOrder(inOrder bool) {
+	s.lock()
+	defer s.unlock()
+
+	s.inAnyOrder = !inOrder
+}
+
 // Append appends an expectation to the sequence.
 func (s *Steps) Append(more ...Step) *Steps { //nolint: unparam
 	s.lock()
@@ -55,24 +125,36 @@ func (s *Steps) Append(more ...Step) *Steps { //nolint: unparam
 	return s
 }
 
-// DoFirst runs the first step.
+// DoFirst runs the first step, or the step that is asked first when the steps are matched in any order.
 func (s *Steps) DoFirst(c Console) error {
+	_, err := s.doFirst(c)
+
+	return err
+}
+
+// doFirst is DoFirst that also returns the step that is done, if any.
+func (s *Steps) doFirst(c Console) (Step, error) {
 	if s.HasNothingToDo() {
-		return ErrNothingToDo
+		return nil, ErrNothingToDo
+	}
+
+	i, err := s.next(c)
+	if err != nil {
+		return nil, err
 	}
 
 	s.lock()
-	step := s.steps[0]
+	step := s.steps[i]
 	s.unlock()
 
 	if err := step.Do(c); err != nil {
 		isNotFinished := errors.Is(err, ErrNotFinished)
 		if !errors.Is(err, terminal.InterruptErr) && !isNotFinished {
-			return err
+			return step, err
 		}
 
 		if isNotFinished {
-			return nil
+			return step, nil
 		}
 	}
 
@@ -80,10 +162,129 @@ func (s *Steps) DoFirst(c Console) error {
 	s.lock()
 	defer s.unlock()
 
-	s.steps[0] = nil
-	s.steps = s.steps[1:]
+	s.removeLocked(i)
 
-	return nil
+	return step, nil
+}
+
+// next returns the position of the step to do. When the first steps are optional, it reads until one of them or the
+// first step that is not optional is asked, and the optional steps before it are skipped. When the steps are matched
+// in any order, it reads until any of them is asked. If the survey ends instead, all the optional steps are skipped.
+func (s *Steps) next(c Console) (int, error) {
+	s.lock()
+	all := make([]Step, len(s.steps))
+	copy(all, s.steps)
+
+	inAnyOrder := s.inAnyOrder
+	read := s.read
+	s.read = nil
+	s.unlock()
+
+	var (
+		candidates  []expectedStep
+		optional    []bool
+		allOptional = true
+	)
+
+	for _, step := range all {
+		p, ok := step.(expectedStep)
+		if !ok {
+			break
+		}
+
+		candidates = append(candidates, p)
+		optional = append(optional, p.isOptional())
+		allOptional = allOptional && optional[len(optional)-1]
+
+		if !inAnyOrder && !optional[len(optional)-1] {
+			break
+		}
+	}
+
+	if len(candidates) == 0 || (read == nil && len(candidates) == 1 && !optional[0]) {
+		return 0, nil
+	}
+
+	var (
+		messages []Matcher
+		owners   []int
+	)
+
+	for i, p := range candidates {
+		for _, m := range p.expectedMessages() {
+			messages = append(messages, m)
+			owners = append(owners, i)
+		}
+	}
+
+	asked := -1
+
+	var match MessageMatch
+
+	if read != nil {
+		// The message has already been read by the steps that this one is part of.
+		for i, m := range messages {
+			if sameMatcher(m, read.message) {
+				asked, match = i, read.match
+
+				break
+			}
+		}
+	} else {
+		// The console is closed when the survey ends, it is not an error if all the steps are optional.
+		buf, err := c.Expect(expectMessages(messages...), expect.EOF, expect.PTSClosed)
+		if err != nil && !allOptional {
+			return 0, err
+		}
+
+		if err == nil {
+			asked, match = lastMatch(buf, messages...)
+		}
+	}
+
+	// The candidates are the first steps, the position of the asked one moves back by the skipped steps before it.
+	var skipped []int
+
+	next := 0
+
+	if asked < 0 {
+		for i := range candidates {
+			if optional[i] {
+				skipped = append(skipped, i)
+			}
+		}
+	} else {
+		next = owners[asked]
+		candidates[next].messageRead(messages[asked], match)
+
+		if !inAnyOrder {
+			for i := 0; i < next; i++ {
+				skipped = append(skipped, i)
+			}
+		}
+
+		next -= len(skipped)
+	}
+
+	s.lock()
+	defer s.unlock()
+
+	for j := len(skipped) - 1; j >= 0; j-- {
+		s.removeLocked(skipped[j])
+	}
+
+	if len(s.steps) == 0 {
+		return 0, ErrNothingToDo
+	}
+
+	return next, nil
+}
+
+// removeLocked removes the step at the given position from the sequence.
+func (s *Steps) removeLocked(i int) {
+	copy(s.steps[i:], s.steps[i+1:])
+	s.steps[len(s.steps)-1] = nil
+	s.steps = s.steps[:len(s.steps)-1]
 }
 
 // Do runs all the steps.
@@ -146,15 +347,91 @@ func (s *Steps) HasNothingToDo() bool {
 	return s.Len() == 0
 }
 
-// ExpectationsWereMet checks whether all queued expectations were met in order.
+// ExpectationsWereMet checks whether all queued expectations were met, optional prompts are not expected to be met.
 // If any of them was not met - an error is returned.
 func (s *Steps) ExpectationsWereMet() error {
-	if s.HasNothingToDo() {
-		return nil
+	s.lock()
+	defer s.unlock()
+
+	if unmet := s.unmetLocked(); unmet != "" {
+		//nolint:goerr113
+		return errors.New(unmet)
 	}
 
-	//nolint:goerr113
-	return errors.New(s.String())
+	return nil
+}
+
+// unmetLocked represents the steps that are not optional as a string.
+func (s *Steps) unmetLocked() string {
+	var sb stringsBuilder
+
+	for _, step := range s.steps {
+		if p, ok := step.(expectedStep); ok && p.isOptionalLocked() {
+			continue
+		}
+
+		if sb.Len() > 0 {
+			sb.WriteString("\n\n")
+		}
+
+		sb.WriteString(step.String())
+	}
+
+	return sb.String()
+}
+
+// allOptional checks whether all the remaining steps are optional, locked tells whether the survey lock is already
+// held.
+func (s *Steps) allOptional(locked bool) bool {
+	s.lock()
+	all := make([]Step, len(s.steps))
+	copy(all, s.steps)
+	s.unlock()
+
+	for _, step := range all {
+		if p, ok := step.(expectedStep); !ok || !isOptional(p, locked) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// firstMessages are the messages of the steps that may be asked next: all of them when the steps are matched in any
+// order, or the first one and the optional ones before it.
+func (s *Steps) firstMessages() []Matcher {
+	s.lock()
+	all := make([]Step, len(s.steps))
+	copy(all, s.steps)
+
+	inAnyOrder := s.inAnyOrder
+	s.unlock()
+
+	var messages []Matcher
+
+	for _, step := range all {
+		p, ok := step.(expectedStep)
+		if !ok {
+			break
+		}
+
+		messages = append(messages, p.expectedMessages()...)
+
+		if !inAnyOrder && !p.isOptional() {
+			break
+		}
+	}
+
+	return messages
+}
+
+// keepRead keeps the message that has been read while looking for this steps, so the step that is asked is done
+// next.
+func (s *Steps) keepRead(message Matcher, match MessageMatch) {
+	s.lock()
+	defer s.unlock()
+
+	s.read = &readMessage{message: message, match: match}
 }
 
 func steps(steps ...Step) *Steps {
@@ -190,12 +467,522 @@ func (s *InlineSteps) String() string {
 	return sb.String()
 }
 
+// clone copies the steps, so they could be done again when the prompt is asked again.
+func (s *InlineSteps) clone() *InlineSteps {
+	s.lock()
+	defer s.unlock()
+
+	c := inlineSteps(make([]Step, len(s.steps))...)
+	c.closed = s.closed
+
+	copy(c.steps, s.steps)
+
+	return c
+}
+
 func inlineSteps(inlineSteps ...Step) *InlineSteps {
 	return &InlineSteps{
 		Steps: steps(inlineSteps...),
 	}
 }
 
+// groupedSteps is a group of steps that is done as one step, the group is not finished until all the steps are done.
+type groupedSteps struct {
+	*Steps
+}
+
+// Do runs the next step, the group is not finished until all the steps are done.
+func (s *groupedSteps) Do(c Console) error {
+	_, err := s.do(c)
+
+	return err
+}
+
+// do is Do that also returns the step that is done, if any.
+func (s *groupedSteps) do(c Console) (Step, error) {
+	step, err := s.doFirst(c)
+	if err != nil && !IsNothingTodo(err) {
+		return step, err
+	}
+
+	if s.HasNothingToDo() {
+		return step, nil
+	}
+
+	return step, ErrNotFinished
+}
+
+// String represents the steps that are not done yet as a string.
+func (s *groupedSteps) String() string {
+	s.lock()
+	defer s.unlock()
+
+	return s.unmetLocked()
+}
+
+// isOptional checks whether all the remaining steps are optional.
+func (s *groupedSteps) isOptional() bool {
+	return s.allOptional(false)
+}
+
+// isOptionalLocked is isOptional when the survey lock is already held.
+func (s *groupedSteps) isOptionalLocked() bool {
+	return s.allOptional(true)
+}
+
+// expectedMessages are the messages of the steps that may be asked next.
+func (s *groupedSteps) expectedMessages() []Matcher {
+	return s.firstMessages()
+}
+
+// messageRead keeps the message, so the step that is asked is done next.
+func (s *groupedSteps) messageRead(message Matcher, match MessageMatch) {
+	s.keepRead(message, match)
+}
+
+func groupSteps(inAnyOrder bool, steps ...Step) *groupedSteps {
+	s := &Steps{steps: steps}
+	s.inAnyOrder = inAnyOrder
+
+	return &groupedSteps{Steps: s}
+}
+
+// UnorderedSteps is a group of steps that are done in the order they are asked.
+type UnorderedSteps struct {
+	*groupedSteps
+}
+
+func unorderedSteps(unorderedSteps ...Step) *UnorderedSteps {
+	return &UnorderedSteps{
+		groupedSteps: groupSteps(true, unorderedSteps...),
+	}
+}
+
+// OneOfSteps is a group of branches of steps, only the branch that is asked is done.
+type OneOfSteps struct {
+	branches []*Steps
+	chosen   *Steps
+
+	mu sync.Mutex
+}
+
+// branch returns the branch that has been asked, or nil if none of them has been asked yet.
+func (s *OneOfSteps) branch() *Steps {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.chosen
+}
+
+// Do chooses the branch that is asked and runs its steps, the group is not finished until all of them are done.
+func (s *OneOfSteps) Do(c Console) error {
+	if s.branch() == nil {
+		messages := s.expectedMessages()
+
+		buf, err := c.Expect(expectMessages(messages...))
+		if err != nil {
+			return fmt.Errorf("%w: %s\n\n%s", ErrNoBranchAsked, err.Error(), s.String())
+		}
+
+		if asked, match := lastMatch(buf, messages...); asked >= 0 {
+			s.messageRead(messages[asked], match)
+		}
+	}
+
+	b := s.branch()
+
+	if err := b.DoFirst(c); err != nil && !IsNothingTodo(err) {
+		return err
+	}
+
+	if b.HasNothingToDo() {
+		return nil
+	}
+
+	return ErrNotFinished
+}
+
+// String represents the branches as a string, or the remaining steps of the branch that has been asked.
+func (s *OneOfSteps) String() string {
+	if b := s.branch(); b != nil {
+		b.lock()
+		defer b.unlock()
+
+		return b.unmetLocked()
+	}
+
+	var sb stringsBuilder
+
+	sb.WriteLabelLinef("Expect", "One Of %d Branches", len(s.branches))
+
+	for i, b := range s.branches {
+		// The branches are separated by an empty line, whether or not the last step ends with a new line.
+		sb.WriteRune('\n').
+			WriteLinef("Branch #%d:", i+1).
+			WriteLinef("%s", strings.TrimSuffix(b.String(), "\n"))
+	}
+
+	return sb.String()
+}
+
+// isOptional checks whether the remaining steps of the branch that has been asked are optional. Before that, the group
+// is optional when any of the branches is.
+func (s *OneOfSteps) isOptional() bool {
+	return s.optional(false)
+}
+
+// isOptionalLocked is isOptional when the survey lock is already held.
+func (s *OneOfSteps) isOptionalLocked() bool {
+	return s.optional(true)
+}
+
+func (s *OneOfSteps) optional(locked bool) bool {
+	if b := s.branch(); b != nil {
+		return b.allOptional(locked)
+	}
+
+	for _, b := range s.branches {
+		if b.allOptional(locked) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expectedMessages are the messages of the first steps of the branches.
+func (s *OneOfSteps) expectedMessages() []Matcher {
+	if b := s.branch(); b != nil {
+		return b.firstMessages()
+	}
+
+	var messages []Matcher
+
+	for _, b := range s.branches {
+		messages = append(messages, b.firstMessages()...)
+	}
+
+	return messages
+}
+
+// messageRead chooses the first branch that starts with the message.
+func (s *OneOfSteps) messageRead(message Matcher, match MessageMatch) {
+	b := s.branch()
+
+	for i := 0; b == nil && i < len(s.branches); i++ {
+		for _, m := range s.branches[i].firstMessages() {
+			if sameMatcher(m, message) {
+				b = s.branches[i]
+
+				break
+			}
+		}
+	}
+
+	if b == nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.chosen = b
+	s.mu.Unlock()
+
+	b.keepRead(message, match)
+}
+
+func oneOfSteps(branches ...*Steps) *OneOfSteps {
+	return &OneOfSteps{
+		branches: branches,
+	}
+}
+
+// RepeatedSteps is a loop of steps that are built for every iteration. The loop ends when the steps of Until are
+// asked instead of the next iteration, or after the maximum number of iterations.
+type RepeatedSteps struct {
+	parent *Survey
+
+	build func(i int, s *Survey)
+	until *Steps
+
+	// Maximum number of iterations, or anyTimes.
+	max int
+
+	// Amount of iterations that have been done.
+	done int
+
+	// current is the next iteration and the steps of Until, only one of them is asked.
+	current *OneOfSteps
+	// pending indicates that the first iteration is not built yet, it is built when it is first needed so that the
+	// loop can be set up before.
+	pending bool
+
+	mu sync.Mutex
+}
+
+// Until sets the steps that end the loop when they are asked instead of the next iteration.
+//
+//	Survey.Repeat(func(i int, s *surveyexpect.Survey) {
+//		s.ExpectConfirm("Add an item?").Yes()
+//		s.ExpectInput("Item:").Answer(items[i])
+//	}).
+//		AtMost(len(items)).
+//		Until(func(s *surveyexpect.Survey) {
+//			s.ExpectConfirm("Add an item?").No()
+//		})
+func (s *RepeatedSteps) Until(fn func(s *Survey)) *RepeatedSteps {
+	until := steps()
+
+	s.parent.within(until, fn)
+
+	s.mu.Lock()
+	s.until = until
+	s.mu.Unlock()
+
+	return s
+}
+
+// AtMost sets the maximum number of iterations, the steps of Until are expected once they are all done. The iteration
+// wins over Until when both of them start with the same prompt.
+//
+//	Survey.Repeat(func(i int, s *surveyexpect.Survey) {
+//		s.ExpectInput("Host name:").Answer(hosts[i])
+//	}).
+//		AtMost(len(hosts))
+func (s *RepeatedSteps) AtMost(i int) *RepeatedSteps {
+	s.mu.Lock()
+	s.max = i
+	s.mu.Unlock()
+
+	return s
+}
+
+// prepare builds the first iteration if it is not built yet. The lock must not be held because the expectations of
+// the iteration are added by the survey.
+func (s *RepeatedSteps) prepare() {
+	s.mu.Lock()
+	pending := s.pending
+	s.mu.Unlock()
+
+	if !pending {
+		return
+	}
+
+	next := s.next()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.current, s.pending = next, false
+}
+
+// next builds the next iteration if there is any. The lock must not be held because the expectations of the
+// iteration are added by the survey.
+func (s *RepeatedSteps) next() *OneOfSteps {
+	s.mu.Lock()
+	i, max, until := s.done, s.max, s.until
+	s.mu.Unlock()
+
+	var branches []*Steps
+
+	if max == anyTimes || i < max {
+		iteration := steps()
+
+		s.parent.within(iteration, func(p *Survey) {
+			s.build(i, p)
+		})
+
+		branches = append(branches, iteration)
+	}
+
+	if until != nil {
+		branches = append(branches, until)
+	}
+
+	if len(branches) == 0 {
+		return nil
+	}
+
+	return oneOfSteps(branches...)
+}
+
+// state returns the current iteration and whether it is the first step of an iteration.
+func (s *RepeatedSteps) state() (*OneOfSteps, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.current, s.current == nil || s.current.branch() == nil
+}
+
+// Do runs the steps of the current iteration, the loop is not finished until it ends.
+func (s *RepeatedSteps) Do(c Console) error {
+	s.prepare()
+
+	current, _ := s.state()
+	if current == nil {
+		return nil
+	}
+
+	if err := current.Do(c); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	ended := s.until != nil && current.branch() == s.until
+
+	if !ended {
+		s.done++
+	}
+
+	s.mu.Unlock()
+
+	var next *OneOfSteps
+
+	if !ended {
+		next = s.next()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current = next; next == nil {
+		return nil
+	}
+
+	return ErrNotFinished
+}
+
+// String represents the progress of the loop as a string.
+func (s *RepeatedSteps) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var sb stringsBuilder
+
+	sb.WriteLabelLinef("Expect", "Repeat")
+
+	if s.max == anyTimes {
+		sb.WriteLabelLinef("Done", "%d iteration(s)", s.done)
+	} else {
+		sb.WriteLabelLinef("Done", "%d of at most %d iteration(s)", s.done, s.max)
+	}
+
+	if s.pending {
+		sb.WriteString(fmt.Sprintf("\nIteration #%d:\nnot built yet\n", s.done+1))
+
+		if s.until != nil {
+			sb.WriteString("\nUntil:\n")
+			sb.WriteLinef("%s", strings.TrimSuffix(s.until.String(), "\n"))
+		}
+
+		return sb.String()
+	}
+
+	if s.current == nil {
+		return sb.String()
+	}
+
+	if b := s.current.branch(); b != nil {
+		b.lock()
+		defer b.unlock()
+
+		if b == s.until {
+			sb.WriteString("\nUntil:\n")
+		} else {
+			sb.WriteString(fmt.Sprintf("\nIteration #%d:\n", s.done+1))
+		}
+
+		sb.WriteLinef("%s", strings.TrimSuffix(b.unmetLocked(), "\n"))
+
+		return sb.String()
+	}
+
+	for _, b := range s.current.branches {
+		if b == s.until {
+			sb.WriteString("\nUntil:\n")
+		} else {
+			sb.WriteString(fmt.Sprintf("\nIteration #%d:\n", s.done+1))
+		}
+
+		sb.WriteLinef("%s", strings.TrimSuffix(b.String(), "\n"))
+	}
+
+	return sb.String()
+}
+
+// isOptional checks whether the loop may end before the next iteration, or whether the remaining steps of the current
+// iteration are optional and the loop may end after it.
+func (s *RepeatedSteps) isOptional() bool {
+	s.prepare()
+
+	return s.optional(false)
+}
+
+// isOptionalLocked is isOptional when the survey lock is already held. The first iteration cannot be built, so it is
+// taken as not started yet.
+func (s *RepeatedSteps) isOptionalLocked() bool {
+	return s.optional(true)
+}
+
+func (s *RepeatedSteps) optional(locked bool) bool {
+	s.mu.Lock()
+	pending, until := s.pending, s.until
+	s.mu.Unlock()
+
+	current, first := s.state()
+
+	switch {
+	case pending:
+		// The loop is at the first step of its first iteration.
+
+	case current == nil:
+		return true
+
+	case !first && !isOptional(current, locked):
+		return false
+	}
+
+	return until == nil || until.allOptional(locked)
+}
+
+// expectedMessages are the messages of the current iteration.
+func (s *RepeatedSteps) expectedMessages() []Matcher {
+	s.prepare()
+
+	current, _ := s.state()
+	if current == nil {
+		return nil
+	}
+
+	return current.expectedMessages()
+}
+
+// messageRead passes the message to the current iteration.
+func (s *RepeatedSteps) messageRead(message Matcher, match MessageMatch) {
+	s.prepare()
+
+	if current, _ := s.state(); current != nil {
+		current.messageRead(message, match)
+	}
+}
+
+func repeatedSteps(parent *Survey, build func(i int, s *Survey)) *RepeatedSteps {
+	return &RepeatedSteps{
+		parent:  parent,
+		build:   build,
+		max:     anyTimes,
+		pending: true,
+	}
+}
+
+// isOptional checks whether the step is optional, locked tells whether the survey lock is already held.
+func isOptional(step expectedStep, locked bool) bool {
+	if locked {
+		return step.isOptionalLocked()
+	}
+
+	return step.isOptional()
+}
+
 func totalTimes(times ...int) int {
 	cnt := len(times)
 
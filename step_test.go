@@ -179,3 +179,33 @@ func TestRepeatSteps(t *testing.T) {
 
 	assert.Equal(t, expected, actual)
 }
+
+func TestSteps_ExpectationsWereMet(t *testing.T) {
+	t.Parallel()
+
+	s := &Survey{}
+
+	t.Run("optional prompts", func(t *testing.T) {
+		t.Parallel()
+
+		st := steps(
+			newConfirm(s, Exact("Overwrite the existing file?")).Maybe(),
+			newInput(s, Exact("Host name:")).AnyTimes(),
+		)
+
+		assert.NoError(t, st.ExpectationsWereMet())
+	})
+
+	t.Run("expected prompts", func(t *testing.T) {
+		t.Parallel()
+
+		st := steps(
+			newConfirm(s, Exact("Overwrite the existing file?")).Maybe(),
+			newInput(s, Exact("Host name:")).AtLeast(1),
+		)
+
+		expected := "Expect : Input Prompt\nMessage: \"Host name:\"\nAnswer : <no answer>\n(called: 0 time(s), remaining: at least 1 time(s))\n"
+
+		assert.EqualError(t, st.ExpectationsWereMet(), expected)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/Netflix/go-expect"
 	pseudotty "github.com/creack/pty"
@@ -25,12 +26,24 @@ type StringWriter interface {
 type Survey struct {
 	steps Steps
 
+	// scope is where the expectations are added to, instead of the steps, while they are being grouped.
+	scope *Steps
+
 	// test is An optional variable that holds the test struct, to be used for logging and raising error during the
 	// tests.
 	test TestingT
 
 	timeout time.Duration
 
+	// secrets are the answers that must not show up in plain text in the output.
+	secrets []secret
+
+	// captures are the named groups of the messages that have been matched.
+	captures map[string]string
+
+	// forms are checked once the survey is done.
+	forms []*Form
+
 	mu      sync.Mutex
 	startMu sync.Mutex
 }
@@ -45,11 +58,168 @@ func (s *Survey) WithTimeout(t time.Duration) *Survey {
 	return s
 }
 
+// MatchExpectationsInOrder sets whether the prompts are expected to be asked in the order they are expected, which is
+// the default. Otherwise, the expected prompt that is asked is answered, whatever its position.
+//
+//	Survey.MatchExpectationsInOrder(false)
+func (s *Survey) MatchExpectationsInOrder(inOrder bool) *Survey {
+	s.steps.matchInOrder(inOrder)
+
+	return s
+}
+
+// InAnyOrder expects the prompts that are expected in fn to be asked in any order, while the group itself keeps its
+// position among the other expectations.
+//
+//	Survey.InAnyOrder(func(s *surveyexpect.Survey) {
+//		s.ExpectInput("Enter your name:").
+//			Answer("John Doe")
+//
+//		s.ExpectConfirm("Subscribe to the newsletter?").
+//			Yes()
+//	})
+func (s *Survey) InAnyOrder(fn func(s *Survey)) {
+	group := unorderedSteps()
+
+	s.within(group.Steps, fn)
+	s.addStep(group)
+}
+
+// ExpectOneOf expects the prompts of only one of the branches, the branch whose first prompt is asked.
+//
+//	Survey.ExpectOneOf(
+//		func(s *surveyexpect.Survey) {
+//			s.ExpectSelect("Select a profile:").
+//				Choose("default")
+//		},
+//		func(s *surveyexpect.Survey) {
+//			s.ExpectInput("Enter a profile name:").
+//				Answer("default")
+//		},
+//	)
+func (s *Survey) ExpectOneOf(branches ...func(s *Survey)) {
+	group := make([]*Steps, len(branches))
+
+	for i, fn := range branches {
+		group[i] = steps()
+
+		s.within(group[i], fn)
+	}
+
+	s.addStep(oneOfSteps(group...))
+}
+
+// Repeat expects a loop of prompts, fn expects the prompts of the iteration i. Without Until, the loop also ends when
+// any other prompt is asked. The first iteration is built when it is first needed, and every next one as soon as the
+// previous one is done, before it is asked.
+//
+//	Survey.Repeat(func(i int, s *surveyexpect.Survey) {
+//		s.ExpectInput("Host name:").
+//			Answer(hosts[i])
+//	}).
+//		AtMost(len(hosts))
+func (s *Survey) Repeat(fn func(i int, s *Survey)) *RepeatedSteps {
+	r := repeatedSteps(s, fn)
+
+	s.addStep(r)
+
+	return r
+}
+
+// within adds the expectations of fn to the given steps instead of the survey.
+func (s *Survey) within(steps *Steps, fn func(s *Survey)) {
+	s.mu.Lock()
+	scope := s.scope
+	s.scope = steps
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.scope = scope
+		s.mu.Unlock()
+	}()
+
+	fn(s)
+}
+
+// Captures returns the named groups of the messages that have been matched so far, the last match wins when a name
+// is captured more than once.
+func (s *Survey) Captures() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	captures := make(map[string]string, len(s.captures))
+
+	for k, v := range s.captures {
+		captures[k] = v
+	}
+
+	return captures
+}
+
+// captureLocked keeps the named groups of a matched message.
+func (s *Survey) captureLocked(captures map[string]string) {
+	if len(captures) == 0 {
+		return
+	}
+
+	if s.captures == nil {
+		s.captures = make(map[string]string, len(captures))
+	}
+
+	for k, v := range captures {
+		s.captures[k] = v
+	}
+}
+
+// secret is an answer that must not show up in plain text in the output.
+type secret struct {
+	message string
+	// text is the text that matches the message, the answer is echoed after it.
+	text   string
+	answer string
+	// hidden checks everything that the survey writes.
+	hidden bool
+	// notEchoed checks the screen where the answer is echoed, after the message.
+	notEchoed bool
+}
+
+// expectHidden expects the answer of the prompt never to show up in plain text in the output.
+func (s *Survey) expectHidden(sec secret) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.secrets = append(s.secrets, sec)
+}
+
+// checkHidden checks whether the secrets show up in plain text. The output is what the survey writes, the keys that
+// are sent to the prompts are not in it, even when the terminal echoes them.
+func (s *Survey) checkHidden(c *terminalConsole) {
+	output := c.output.String()
+	screen := c.history()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sec := range s.secrets {
+		if (sec.hidden && strings.Contains(output, sec.answer)) ||
+			(sec.notEchoed && echoesAnswer(screen, sec.text, sec.answer)) {
+			s.test.Errorf("%s: the answer to %s", ErrPlaintextShown.Error(), sec.message)
+		}
+	}
+}
+
 // addStep adds a new step to the sequence.
 func (s *Survey) addStep(step Step) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.scope != nil {
+		s.scope.Append(step)
+
+		return
+	}
+
 	s.steps.Append(step)
 }
 
@@ -58,7 +228,35 @@ func (s *Survey) addStep(step Step) {
 //	Survey.ExpectConfirm("ConfirmPrompt?").
 //		Yes()
 func (s *Survey) ExpectConfirm(message string) *ConfirmPrompt {
-	e := newConfirm(s, message)
+	return s.ExpectConfirmMatch(Exact(message))
+}
+
+// ExpectConfirmMatch expects a ConfirmPrompt whose message is matched by a Matcher.
+//
+//	Survey.ExpectConfirmMatch(surveyexpect.Regexp(`Delete (?P<count>\d+) files\?`)).
+//		Yes()
+func (s *Survey) ExpectConfirmMatch(message Matcher) *ConfirmPrompt {
+	e := newConfirm(s, message).Once()
+
+	s.addStep(e)
+
+	return e
+}
+
+// ExpectEditor expects an EditorPrompt.
+//
+//	Survey.ExpectEditor("Enter a commit message:").
+//		Answer("Fix typo")
+func (s *Survey) ExpectEditor(message string) *EditorPrompt {
+	return s.ExpectEditorMatch(Exact(message))
+}
+
+// ExpectEditorMatch expects an EditorPrompt whose message is matched by a Matcher.
+//
+//	Survey.ExpectEditorMatch(surveyexpect.Glob("Edit /tmp/*/COMMIT_EDITMSG:")).
+//		Answer("Fix typo")
+func (s *Survey) ExpectEditorMatch(message Matcher) *EditorPrompt {
+	e := newEditor(s, message).Once()
 
 	s.addStep(e)
 
@@ -70,6 +268,14 @@ func (s *Survey) ExpectConfirm(message string) *ConfirmPrompt {
 //	Survey.ExpectInput("Enter password:").
 //		Answer("hello world!")
 func (s *Survey) ExpectInput(message string) *InputPrompt {
+	return s.ExpectInputMatch(Exact(message))
+}
+
+// ExpectInputMatch expects an InputPrompt whose message is matched by a Matcher.
+//
+//	Survey.ExpectInputMatch(surveyexpect.CaseInsensitive("enter your name:")).
+//		Answer("John Doe")
+func (s *Survey) ExpectInputMatch(message Matcher) *InputPrompt {
 	e := newInput(s, message).Once()
 
 	s.addStep(e)
@@ -82,7 +288,15 @@ func (s *Survey) ExpectInput(message string) *InputPrompt {
 //	Survey.ExpectMultiline("Enter password:").
 //		Answer("hello world")
 func (s *Survey) ExpectMultiline(message string) *MultilinePrompt {
-	e := newMultiline(s, message)
+	return s.ExpectMultilineMatch(Exact(message))
+}
+
+// ExpectMultilineMatch expects a MultilinePrompt whose message is matched by a Matcher.
+//
+//	Survey.ExpectMultilineMatch(surveyexpect.NormalizedSpace("Enter your  message:")).
+//		Answer("hello world")
+func (s *Survey) ExpectMultilineMatch(message Matcher) *MultilinePrompt {
+	e := newMultiline(s, message).Once()
 
 	s.addStep(e)
 
@@ -94,7 +308,15 @@ func (s *Survey) ExpectMultiline(message string) *MultilinePrompt {
 //	Survey.ExpectMultiSelect("Enter password:").
 //		Enter()
 func (s *Survey) ExpectMultiSelect(message string) *MultiSelectPrompt {
-	e := newMultiSelect(s, message)
+	return s.ExpectMultiSelectMatch(Exact(message))
+}
+
+// ExpectMultiSelectMatch expects a MultiSelectPrompt whose message is matched by a Matcher.
+//
+//	Survey.ExpectMultiSelectMatch(surveyexpect.Glob("Select the files in /tmp/*:")).
+//		Enter()
+func (s *Survey) ExpectMultiSelectMatch(message Matcher) *MultiSelectPrompt {
+	e := newMultiSelect(s, message).Once()
 
 	s.addStep(e)
 
@@ -106,6 +328,14 @@ func (s *Survey) ExpectMultiSelect(message string) *MultiSelectPrompt {
 //	Survey.ExpectPassword("Enter password:").
 //		Answer("hello world!")
 func (s *Survey) ExpectPassword(message string) *PasswordPrompt {
+	return s.ExpectPasswordMatch(Exact(message))
+}
+
+// ExpectPasswordMatch expects a PasswordPrompt whose message is matched by a Matcher.
+//
+//	Survey.ExpectPasswordMatch(surveyexpect.Regexp(`Enter the password of \w+:`)).
+//		Answer("hello world!")
+func (s *Survey) ExpectPasswordMatch(message Matcher) *PasswordPrompt {
 	e := newPassword(s, message).Once()
 
 	s.addStep(e)
@@ -118,13 +348,54 @@ func (s *Survey) ExpectPassword(message string) *PasswordPrompt {
 //	Survey.ExpectSelect("Enter password:").
 //		Enter()
 func (s *Survey) ExpectSelect(message string) *SelectPrompt {
-	e := newSelect(s, message)
+	return s.ExpectSelectMatch(Exact(message))
+}
+
+// ExpectSelectMatch expects a SelectPrompt whose message is matched by a Matcher.
+//
+//	Survey.ExpectSelectMatch(surveyexpect.CaseInsensitive("select a region:")).
+//		Enter()
+func (s *Survey) ExpectSelectMatch(message Matcher) *SelectPrompt {
+	e := newSelect(s, message).Once()
 
 	s.addStep(e)
 
 	return e
 }
 
+// ExpectForm expects the questions of survey.Ask, the prompts are expected by the names of the questions. Once the
+// survey is done, the answers that survey writes to dst, a pointer to a struct or a map, are checked.
+//
+//	Survey.ExpectForm(questions, &answers).
+//		Input("name").
+//		Answer("John Doe")
+func (s *Survey) ExpectForm(questions []*survey.Question, dst interface{}) *Form {
+	f := newForm(s, questions, dst)
+
+	s.addStep(f)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.forms = append(s.forms, f)
+
+	return f
+}
+
+// checkForms checks the answers that survey writes for the forms.
+func (s *Survey) checkForms() {
+	s.mu.Lock()
+	forms := make([]*Form, len(s.forms))
+	copy(forms, s.forms)
+	s.mu.Unlock()
+
+	for _, f := range forms {
+		for _, err := range f.checkAnswers() {
+			s.test.Errorf(err.Error())
+		}
+	}
+}
+
 // Expect runs an expectation against a given console.
 func (s *Survey) Expect(c Console) error {
 	if err := s.steps.DoFirst(c); !IsIgnoredError(err) {
@@ -151,7 +422,8 @@ func (s *Survey) answer(c Console) <-chan struct{} {
 			default:
 				// If not, we run the expectation.
 				if err := s.Expect(c); err != nil {
-					if !IsNothingTodo(err) {
+					// A read that exceeds its deadline after the answer timeout is the timeout that is already reported.
+					if !IsNothingTodo(err) && !(isDone(sig) && isTimeout(err)) {
 						s.test.Errorf(err.Error())
 					}
 
@@ -177,8 +449,8 @@ func (s *Survey) answer(c Console) <-chan struct{} {
 	return sig.Done()
 }
 
-// ask runs the survey.
-func (s *Survey) ask(c Console, fn func(stdio terminal.Stdio)) <-chan struct{} {
+// ask runs the survey, everything that the survey writes is also recorded to the output.
+func (s *Survey) ask(c Console, output io.Writer, fn func(stdio terminal.Stdio)) <-chan struct{} {
 	sig := NewSignal()
 
 	go func() {
@@ -194,7 +466,7 @@ func (s *Survey) ask(c Console, fn func(stdio terminal.Stdio)) <-chan struct{} {
 			sig.Notify()
 		}()
 
-		fn(stdio(c))
+		fn(recordStdio(stdio(c), output))
 	}()
 
 	go func() {
@@ -224,22 +496,32 @@ func (s *Survey) Start(fn func(stdio terminal.Stdio)) {
 	// Setup a console.
 	buf := new(Buffer)
 
-	console, err := expect.NewConsole(
+	ec, err := expect.NewConsole(
 		expect.WithStdin(pty),
 		expect.WithStdout(term),
 		expect.WithStdout(buf),
 		expect.WithCloser(pty, tty),
+		// Reading the screen sets a short deadline, the default timeout resets it for the next expectations.
+		expect.WithDefaultTimeout(s.timeout),
 	)
 	require.NoError(s.test, err)
 
+	// The console may be closed before reading everything that the survey writes, so the output is also recorded.
+	output := new(Buffer)
+
+	console := &terminalConsole{Console: ec, term: term, output: output}
+
 	// Run the survey in background and close console when it is done.
-	askDone := s.ask(console, fn)
+	askDone := s.ask(console, output, fn)
 
 	// Run the answer in background.
 	// Wait til the survey is done answering.
 	<-s.answer(console)
 	<-askDone
 
+	s.checkHidden(console)
+	s.checkForms()
+
 	s.test.Logf("Raw output: %q\n", buf.String())
 
 	// Dump the terminal's screen.
@@ -272,6 +554,29 @@ func (s *Survey) ResetExpectations() {
 	defer s.mu.Unlock()
 
 	s.steps.Reset()
+	s.forms = nil
+}
+
+// recordedWriter is a terminal.FileWriter that also records everything that is written.
+type recordedWriter struct {
+	terminal.FileWriter
+
+	record io.Writer
+}
+
+// Write writes to the terminal and records it.
+func (w *recordedWriter) Write(p []byte) (int, error) {
+	_, _ = w.record.Write(p) //nolint: errcheck
+
+	return w.FileWriter.Write(p)
+}
+
+// recordStdio records everything that is written to the outputs of the terminal.Stdio.
+func recordStdio(s terminal.Stdio, record io.Writer) terminal.Stdio {
+	s.Out = &recordedWriter{FileWriter: s.Out, record: record}
+	s.Err = io.MultiWriter(s.Err, record)
+
+	return s
 }
 
 // stdio returns a terminal.Stdio of the given console.
@@ -1,11 +1,16 @@
 package surveyexpect_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/stretchr/testify/assert"
 
 	"go.nhat.io/surveyexpect"
+	"go.nhat.io/surveyexpect/options"
 )
 
 func TestSurvey_ExpectNoExpectation(t *testing.T) {
@@ -28,3 +33,616 @@ func TestSurvey_ResetExpectations(t *testing.T) {
 
 	assert.NoError(t, s.ExpectationsWereMet())
 }
+
+func TestSurvey_Cardinality(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario        string
+		expectSurvey    surveyexpect.Expector
+		overwrite       bool
+		hosts           int
+		expectedAnswers []string
+	}{
+		{
+			scenario: "maybe is skipped",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("Overwrite the existing file?").
+					Maybe().
+					Yes()
+
+				s.ExpectInput("Host name:").
+					Answer("alpha")
+			}),
+			hosts:           1,
+			expectedAnswers: []string{"alpha"},
+		},
+		{
+			scenario: "maybe is asked",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("Overwrite the existing file?").
+					Maybe().
+					Yes()
+
+				s.ExpectInput("Host name:").
+					Answer("alpha")
+			}),
+			overwrite:       true,
+			hosts:           1,
+			expectedAnswers: []string{"alpha"},
+		},
+		{
+			scenario: "any times is skipped at the end",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("Overwrite the existing file?").
+					Yes()
+
+				s.ExpectInput("Host name:").
+					AnyTimes().
+					Answer("alpha")
+			}),
+			overwrite: true,
+		},
+		{
+			scenario: "any times",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Host name:").
+					AnyTimes().
+					Answer("alpha")
+			}),
+			hosts:           3,
+			expectedAnswers: []string{"alpha", "alpha", "alpha"},
+		},
+		{
+			scenario: "at least",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Host name:").
+					AtLeast(2).
+					Answer("alpha")
+			}),
+			hosts:           3,
+			expectedAnswers: []string{"alpha", "alpha", "alpha"},
+		},
+		{
+			scenario: "at most",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("Overwrite the existing file?").
+					AtMost(2).
+					Yes()
+
+				s.ExpectInput("Host name:").
+					AtMost(3).
+					Answer("alpha")
+			}),
+			overwrite:       true,
+			hosts:           2,
+			expectedAnswers: []string{"alpha", "alpha"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			s := tc.expectSurvey(t)
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				if tc.overwrite {
+					var overwrite bool
+					err := survey.AskOne(&survey.Confirm{Message: "Overwrite the existing file?"}, &overwrite, options.WithStdio(stdio))
+
+					assert.True(t, overwrite)
+					assert.NoError(t, err)
+				}
+
+				var answers []string
+
+				for i := 0; i < tc.hosts; i++ {
+					var answer string
+					err := survey.AskOne(&survey.Input{Message: "Host name:"}, &answer, options.WithStdio(stdio))
+
+					assert.NoError(t, err)
+
+					answers = append(answers, answer)
+				}
+
+				assert.Equal(t, tc.expectedAnswers, answers)
+			})
+		})
+	}
+}
+
+func TestSurvey_AtLeastNotMet(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(100 * time.Millisecond)
+
+		s.ExpectInput("Host name:").
+			AtLeast(2).
+			Answer("alpha")
+	})(testingT)
+
+	expectedError := "there are remaining expectations that were not met:\n\nExpect : Input Prompt\nMessage: \"Host name:\"\nAnswer : \"alpha\"\n(called: 1 time(s), remaining: at least 1 time(s))\n"
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var answer string
+		_ = survey.AskOne(&survey.Input{Message: "Host name:"}, &answer, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.EqualError(t, s.ExpectationsWereMet(), expectedError)
+}
+
+func TestSurvey_InAnyOrder(t *testing.T) {
+	t.Parallel()
+
+	askConfirm := func(t *testing.T, stdio terminal.Stdio) {
+		t.Helper()
+
+		var overwrite bool
+		err := survey.AskOne(&survey.Confirm{Message: "Overwrite the existing file?"}, &overwrite, options.WithStdio(stdio))
+
+		assert.True(t, overwrite)
+		assert.NoError(t, err)
+	}
+
+	askInput := func(message, expected string) func(t *testing.T, stdio terminal.Stdio) {
+		return func(t *testing.T, stdio terminal.Stdio) {
+			t.Helper()
+
+			var answer string
+			err := survey.AskOne(&survey.Input{Message: message}, &answer, options.WithStdio(stdio))
+
+			assert.Equal(t, expected, answer)
+			assert.NoError(t, err)
+		}
+	}
+
+	askSelect := func(t *testing.T, stdio terminal.Stdio) {
+		t.Helper()
+
+		var region string
+		err := survey.AskOne(&survey.Select{Message: "Region:", Options: []string{"eu", "us"}}, &region, options.WithStdio(stdio))
+
+		assert.Equal(t, "us", region)
+		assert.NoError(t, err)
+	}
+
+	testCases := []struct {
+		scenario     string
+		expectSurvey surveyexpect.Expector
+		questions    []func(t *testing.T, stdio terminal.Stdio)
+	}{
+		{
+			scenario: "survey in any order",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.MatchExpectationsInOrder(false)
+
+				s.ExpectInput("Host name:").
+					Answer("alpha")
+
+				s.ExpectConfirm("Overwrite the existing file?").
+					Yes()
+
+				s.ExpectSelect("Region:").
+					Choose("us")
+			}),
+			questions: []func(t *testing.T, stdio terminal.Stdio){
+				askSelect,
+				askConfirm,
+				askInput("Host name:", "alpha"),
+			},
+		},
+		{
+			scenario: "group in any order",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.ExpectInput("Project:").
+					Answer("demo")
+
+				s.InAnyOrder(func(s *surveyexpect.Survey) {
+					s.ExpectInput("Host name:").
+						Answer("alpha")
+
+					s.ExpectConfirm("Overwrite the existing file?").
+						Yes()
+				})
+
+				s.ExpectSelect("Region:").
+					Choose("us")
+			}),
+			questions: []func(t *testing.T, stdio terminal.Stdio){
+				askInput("Project:", "demo"),
+				askConfirm,
+				askInput("Host name:", "alpha"),
+				askSelect,
+			},
+		},
+		{
+			scenario: "optional in any order is skipped",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.InAnyOrder(func(s *surveyexpect.Survey) {
+					s.ExpectConfirm("Overwrite the existing file?").
+						Maybe().
+						Yes()
+
+					s.ExpectInput("Host name:").
+						Answer("alpha")
+				})
+
+				s.ExpectSelect("Region:").
+					Choose("us")
+			}),
+			questions: []func(t *testing.T, stdio terminal.Stdio){
+				askInput("Host name:", "alpha"),
+				askSelect,
+			},
+		},
+		{
+			scenario: "repeated in any order",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.InAnyOrder(func(s *surveyexpect.Survey) {
+					s.ExpectInput("Host name:").
+						Twice().
+						Answer("alpha")
+
+					s.ExpectConfirm("Overwrite the existing file?").
+						Yes()
+				})
+			}),
+			questions: []func(t *testing.T, stdio terminal.Stdio){
+				askInput("Host name:", "alpha"),
+				askConfirm,
+				askInput("Host name:", "alpha"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			s := tc.expectSurvey(t)
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				for _, ask := range tc.questions {
+					ask(t, stdio)
+				}
+			})
+		})
+	}
+}
+
+func TestSurvey_InAnyOrderNotMet(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(100 * time.Millisecond)
+
+		s.InAnyOrder(func(s *surveyexpect.Survey) {
+			s.ExpectInput("Host name:").
+				Answer("alpha")
+
+			s.ExpectConfirm("Overwrite the existing file?").
+				Yes()
+		})
+	})(testingT)
+
+	expectedError := "there are remaining expectations that were not met:\n\nExpect : Input Prompt\nMessage: \"Host name:\"\nAnswer : \"alpha\"\n"
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var overwrite bool
+		_ = survey.AskOne(&survey.Confirm{Message: "Overwrite the existing file?"}, &overwrite, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.EqualError(t, s.ExpectationsWereMet(), expectedError)
+}
+
+func TestSurvey_ExpectOneOf(t *testing.T) {
+	t.Parallel()
+
+	expectProfile := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.ExpectOneOf(
+			func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a profile:").
+					Choose("staging")
+			},
+			func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a profile name:").
+					Answer("staging")
+
+				s.ExpectConfirm("Save the profile?").
+					Yes()
+			},
+		)
+
+		s.ExpectInput("Host name:").
+			Answer("alpha")
+	})
+
+	testCases := []struct {
+		scenario string
+		profiles []string
+	}{
+		{
+			scenario: "first branch",
+			profiles: []string{"default", "staging"},
+		},
+		{
+			scenario: "second branch",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			s := expectProfile(t)
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				var profile string
+
+				if len(tc.profiles) > 0 {
+					err := survey.AskOne(&survey.Select{Message: "Select a profile:", Options: tc.profiles}, &profile, options.WithStdio(stdio))
+
+					assert.NoError(t, err)
+				} else {
+					var save bool
+
+					err := survey.AskOne(&survey.Input{Message: "Enter a profile name:"}, &profile, options.WithStdio(stdio))
+					assert.NoError(t, err)
+
+					err = survey.AskOne(&survey.Confirm{Message: "Save the profile?"}, &save, options.WithStdio(stdio))
+					assert.NoError(t, err)
+					assert.True(t, save)
+				}
+
+				assert.Equal(t, "staging", profile)
+
+				var host string
+				err := survey.AskOne(&survey.Input{Message: "Host name:"}, &host, options.WithStdio(stdio))
+
+				assert.NoError(t, err)
+				assert.Equal(t, "alpha", host)
+			})
+		})
+	}
+}
+
+func TestSurvey_ExpectOneOfNotAsked(t *testing.T) {
+	t.Parallel()
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(100 * time.Millisecond)
+
+		s.ExpectOneOf(
+			func(s *surveyexpect.Survey) {
+				s.ExpectSelect("Select a profile:").
+					Choose("staging")
+			},
+			func(s *surveyexpect.Survey) {
+				s.ExpectInput("Enter a profile name:").
+					Answer("staging")
+			},
+		)
+	})(testingT)
+
+	expectedError := "there are remaining expectations that were not met:\n\nExpect : One Of 2 Branches\n\nBranch #1:\nExpect : Select Prompt\nMessage: \"Select a profile:\"\nchoose \"staging\"\n\nBranch #2:\nExpect : Input Prompt\nMessage: \"Enter a profile name:\"\nAnswer : \"staging\"\n"
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var host string
+		_ = survey.AskOne(&survey.Input{Message: "Host name:"}, &host, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.EqualError(t, s.ExpectationsWereMet(), expectedError)
+}
+
+func TestSurvey_Repeat(t *testing.T) {
+	t.Parallel()
+
+	items := []string{"apple", "banana"}
+
+	askItems := func(t *testing.T, stdio terminal.Stdio) {
+		t.Helper()
+
+		var answers []string
+
+		for {
+			var more bool
+			err := survey.AskOne(&survey.Confirm{Message: "Add an item?"}, &more, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+
+			if !more {
+				break
+			}
+
+			var item string
+			err = survey.AskOne(&survey.Input{Message: "Item:"}, &item, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+
+			answers = append(answers, item)
+		}
+
+		assert.Equal(t, items, answers)
+	}
+
+	askHosts := func(t *testing.T, stdio terminal.Stdio) {
+		t.Helper()
+
+		for i := 0; i < 3; i++ {
+			var host string
+			err := survey.AskOne(&survey.Input{Message: "Host name:"}, &host, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("host-%d", i), host)
+		}
+	}
+
+	askConfirm := func(t *testing.T, stdio terminal.Stdio) {
+		t.Helper()
+
+		var overwrite bool
+		err := survey.AskOne(&survey.Confirm{Message: "Overwrite the existing file?"}, &overwrite, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+		assert.True(t, overwrite)
+	}
+
+	expectHosts := func(i int, s *surveyexpect.Survey) {
+		s.ExpectInput("Host name:").
+			Answer(fmt.Sprintf("host-%d", i))
+	}
+
+	testCases := []struct {
+		scenario     string
+		expectSurvey surveyexpect.Expector
+		questions    []func(t *testing.T, stdio terminal.Stdio)
+	}{
+		{
+			scenario: "until the same prompt after at most",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.Repeat(func(i int, s *surveyexpect.Survey) {
+					s.ExpectConfirm("Add an item?").
+						Yes()
+
+					s.ExpectInput("Item:").
+						Answer(items[i])
+				}).
+					AtMost(len(items)).
+					Until(func(s *surveyexpect.Survey) {
+						s.ExpectConfirm("Add an item?").
+							No()
+					})
+			}),
+			questions: []func(t *testing.T, stdio terminal.Stdio){askItems},
+		},
+		{
+			scenario: "until another prompt",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.Repeat(expectHosts).
+					Until(func(s *surveyexpect.Survey) {
+						s.ExpectConfirm("Overwrite the existing file?").
+							Yes()
+					})
+			}),
+			questions: []func(t *testing.T, stdio terminal.Stdio){askHosts, askConfirm},
+		},
+		{
+			scenario: "without until",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.Repeat(expectHosts)
+
+				s.ExpectConfirm("Overwrite the existing file?").
+					Yes()
+			}),
+			questions: []func(t *testing.T, stdio terminal.Stdio){askHosts, askConfirm},
+		},
+		{
+			scenario: "at most at the end",
+			expectSurvey: surveyexpect.Expect(func(s *surveyexpect.Survey) {
+				s.Repeat(expectHosts).
+					AtMost(3)
+			}),
+			questions: []func(t *testing.T, stdio terminal.Stdio){askHosts},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			s := tc.expectSurvey(t)
+
+			// Start the survey.
+			s.Start(func(stdio terminal.Stdio) {
+				for _, ask := range tc.questions {
+					ask(t, stdio)
+				}
+			})
+		})
+	}
+}
+
+func TestSurvey_RepeatNotFinished(t *testing.T) {
+	t.Parallel()
+
+	items := []string{"apple", "banana"}
+
+	testingT := T()
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.WithTimeout(100 * time.Millisecond)
+
+		s.Repeat(func(i int, s *surveyexpect.Survey) {
+			s.ExpectInput("Item:").
+				Answer(items[i])
+		}).
+			AtMost(len(items)).
+			Until(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("Done?").
+					Yes()
+			})
+	})(testingT)
+
+	expectedError := "there are remaining expectations that were not met:\n\nExpect : Repeat\nDone   : 1 of at most 2 iteration(s)\n\nIteration #2:\nExpect : Input Prompt\nMessage: \"Item:\"\nAnswer : \"banana\"\n\nUntil:\nExpect : Confirm Prompt\nMessage: \"Done?\"\nAnswer : \"yes\"\n"
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		var item string
+		_ = survey.AskOne(&survey.Input{Message: "Item:"}, &item, options.WithStdio(stdio)) //nolint: errcheck
+	})
+
+	assert.EqualError(t, s.ExpectationsWereMet(), expectedError)
+}
+
+func TestSurvey_RepeatBuildsEachIterationOnce(t *testing.T) {
+	t.Parallel()
+
+	items := []string{"apple", "banana"}
+
+	var built []int
+
+	s := surveyexpect.Expect(func(s *surveyexpect.Survey) {
+		s.Repeat(func(i int, s *surveyexpect.Survey) {
+			built = append(built, i)
+
+			s.ExpectInput("Item:").
+				Answer(items[i])
+		}).
+			Until(func(s *surveyexpect.Survey) {
+				s.ExpectConfirm("Done?").
+					Yes()
+			}).
+			AtMost(len(items))
+	})(t)
+
+	// Start the survey.
+	s.Start(func(stdio terminal.Stdio) {
+		for range items {
+			var item string
+			err := survey.AskOne(&survey.Input{Message: "Item:"}, &item, options.WithStdio(stdio))
+
+			assert.NoError(t, err)
+		}
+
+		var done bool
+		err := survey.AskOne(&survey.Confirm{Message: "Done?"}, &done, options.WithStdio(stdio))
+
+		assert.NoError(t, err)
+	})
+
+	assert.Equal(t, []int{0, 1}, built)
+}